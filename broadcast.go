@@ -0,0 +1,268 @@
+package timespan
+
+import "time"
+
+const (
+	BroadcastWeek    Period = "broadcastweek"
+	BroadcastMonth   Period = "broadcastmonth"
+	BroadcastQuarter Period = "broadcastquarter"
+	BroadcastYear    Period = "broadcastyear"
+)
+
+// BroadcastWindow is a period of the broadcast calendar, where weeks run
+// Monday to Sunday and a broadcast month ends on the last Sunday of the
+// calendar month.
+type BroadcastWindow struct {
+	start           time.Time
+	end             time.Time
+	anchor          Anchor
+	shouldBeLastDay bool
+	period          Period
+}
+
+func (b *BroadcastWindow) Index() int {
+	return broadcastIndex(b.period, b.end)
+}
+
+func (b *BroadcastWindow) BroadcastYear() int {
+	y, _ := broadcastMonthOf(b.end)
+	return y
+}
+
+func (b *BroadcastWindow) Period() Period { return b.period }
+
+func (b *BroadcastWindow) Validate() error {
+	return validateSpan(b.period, b.anchor, b.start, b.end, b.spanFunc())
+}
+
+func (b *BroadcastWindow) Start() time.Time { return b.start }
+func (b *BroadcastWindow) SetStart(t time.Time) {
+	b.start = t
+	if b.anchor == StartAnchor {
+		b.shouldBeLastDay = isSpanEnd(b.spanFunc(), t)
+	}
+}
+
+func (b *BroadcastWindow) End() time.Time { return b.end }
+func (b *BroadcastWindow) SetEnd(t time.Time) {
+	b.end = t
+	if b.anchor == EndAnchor {
+		b.shouldBeLastDay = isSpanEnd(b.spanFunc(), t)
+	}
+}
+
+func (b *BroadcastWindow) WithStart(t time.Time) Window {
+	c := *b
+	c.SetStart(t)
+	return &c
+}
+
+func (b *BroadcastWindow) WithEnd(t time.Time) Window {
+	c := *b
+	c.SetEnd(t)
+	return &c
+}
+
+func (b *BroadcastWindow) Next(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return b.shiftYear(1)
+	}
+
+	return b.shift(1)
+}
+
+func (b *BroadcastWindow) Prev(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return b.shiftYear(-1)
+	}
+
+	return b.shift(-1)
+}
+
+func (b *BroadcastWindow) Complete() Window {
+	ref := b.end
+	if b.anchor == StartAnchor {
+		ref = b.start
+	}
+
+	start, end := broadcastBounds(b.period, ref)
+
+	return &BroadcastWindow{
+		start:           start,
+		end:             end,
+		anchor:          b.anchor,
+		shouldBeLastDay: true,
+		period:          b.period,
+	}
+}
+
+func (b *BroadcastWindow) shift(delta int) Window {
+	ref := b.end
+	if b.anchor == StartAnchor {
+		ref = b.start
+	}
+
+	ref = shiftSpan(b.spanFunc(), ref, delta, b.shouldBeLastDay)
+
+	return newBroadcastWindow(b.period, b.anchor, ref)
+}
+
+// shiftYear moves to the same week, month or quarter of the adjacent
+// broadcast year.
+func (b *BroadcastWindow) shiftYear(delta int) Window {
+	switch b.period {
+	case BroadcastWeek:
+		ref := b.end
+		if b.anchor == StartAnchor {
+			ref = b.start
+		}
+
+		week := b.Index()
+		offset := isoWeekday(ref)
+
+		start, end := broadcastBounds(BroadcastYear, shiftSpan(broadcastYearBounds, ref, delta, false))
+		ref = start.AddDate(0, 0, 7*(week-1)+offset)
+		if ref.After(end) {
+			ref = end
+		}
+
+		return newBroadcastWindow(b.period, b.anchor, ref)
+	case BroadcastMonth:
+		return b.shift(12 * delta)
+	case BroadcastQuarter:
+		return b.shift(4 * delta)
+	default:
+		return b.shift(delta)
+	}
+}
+
+func (b *BroadcastWindow) spanFunc() spanFunc {
+	return func(t time.Time) (time.Time, time.Time) {
+		return broadcastBounds(b.period, t)
+	}
+}
+
+func NewBroadcastWeekWindowStartingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastWeek, StartAnchor, t)
+}
+
+func NewBroadcastWeekWindowEndingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastWeek, EndAnchor, t)
+}
+
+func NewBroadcastMonthWindowStartingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastMonth, StartAnchor, t)
+}
+
+func NewBroadcastMonthWindowEndingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastMonth, EndAnchor, t)
+}
+
+func NewBroadcastQuarterWindowStartingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastQuarter, StartAnchor, t)
+}
+
+func NewBroadcastQuarterWindowEndingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastQuarter, EndAnchor, t)
+}
+
+func NewBroadcastYearWindowStartingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastYear, StartAnchor, t)
+}
+
+func NewBroadcastYearWindowEndingOn(t time.Time) Window {
+	return newBroadcastWindow(BroadcastYear, EndAnchor, t)
+}
+
+func newBroadcastWindow(p Period, a Anchor, t time.Time) Window {
+	start, end := broadcastBounds(p, t)
+
+	w := &BroadcastWindow{
+		start:           start,
+		end:             end,
+		anchor:          a,
+		shouldBeLastDay: truncateToDay(t).Equal(end),
+		period:          p,
+	}
+
+	if a == StartAnchor {
+		w.start = truncateToDay(t)
+	} else {
+		w.end = truncateToDay(t)
+	}
+
+	return w
+}
+
+// broadcastMonthEnd returns the last Sunday of the calendar month.
+func broadcastMonthEnd(y int, m time.Month, loc *time.Location) time.Time {
+	last := time.Date(y, m+1, 0, 0, 0, 0, 0, loc)
+	return last.AddDate(0, 0, -int(last.Weekday()))
+}
+
+// broadcastMonthOf returns the broadcast month containing t.
+func broadcastMonthOf(t time.Time) (int, time.Month) {
+	t = truncateToDay(t)
+	y, m, _ := t.Date()
+
+	if t.After(broadcastMonthEnd(y, m, t.Location())) {
+		next := time.Date(y, m+1, 1, 0, 0, 0, 0, t.Location())
+		return next.Year(), next.Month()
+	}
+	return y, m
+}
+
+// broadcastIndex returns the 1-based position within its broadcast year of
+// the period p containing t.
+func broadcastIndex(p Period, t time.Time) int {
+	_, m := broadcastMonthOf(t)
+
+	switch p {
+	case BroadcastWeek:
+		_, week := broadcastWeekOf(t)
+		return week
+	case BroadcastMonth:
+		return int(m)
+	case BroadcastQuarter:
+		return (int(m)-1)/3 + 1
+	default:
+		return 1
+	}
+}
+
+// broadcastWeekOf returns the broadcast year of t and the 1-based number of
+// the broadcast week containing t within it.
+func broadcastWeekOf(t time.Time) (year, week int) {
+	start, _ := broadcastBounds(BroadcastYear, t)
+	year, _ = broadcastMonthOf(t)
+	return year, daysBetween(start, t)/7 + 1
+}
+
+func broadcastMonthBounds(y int, m time.Month, loc *time.Location) (start, end time.Time) {
+	return broadcastMonthEnd(y, m-1, loc).AddDate(0, 0, 1), broadcastMonthEnd(y, m, loc)
+}
+
+func broadcastYearBounds(t time.Time) (start, end time.Time) {
+	return broadcastBounds(BroadcastYear, t)
+}
+
+func broadcastBounds(p Period, t time.Time) (start, end time.Time) {
+	y, m := broadcastMonthOf(t)
+	loc := t.Location()
+
+	switch p {
+	case BroadcastWeek:
+		return isoWeekStart(t), isoWeekEnd(t)
+	case BroadcastMonth:
+		return broadcastMonthBounds(y, m, loc)
+	case BroadcastQuarter:
+		first := (m-1)/3*3 + 1
+		start, _ = broadcastMonthBounds(y, first, loc)
+		_, end = broadcastMonthBounds(y, first+2, loc)
+		return start, end
+	default:
+		start, _ = broadcastMonthBounds(y, time.January, loc)
+		_, end = broadcastMonthBounds(y, time.December, loc)
+		return start, end
+	}
+}
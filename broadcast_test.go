@@ -0,0 +1,119 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestBroadcastWindow_Complete(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func(time.Time) timespan.Window
+		input     string
+		wantStart string
+		wantEnd   string
+		wantIndex int
+		wantYear  int
+	}{
+		{"january starts in december", timespan.NewBroadcastMonthWindowEndingOn, "2026-01-10", "2025-12-29", "2026-01-25", 1, 2026},
+		{"late january belongs to february", timespan.NewBroadcastMonthWindowStartingOn, "2026-01-27", "2026-01-26", "2026-02-22", 2, 2026},
+		{"month ending on sunday", timespan.NewBroadcastMonthWindowEndingOn, "2025-11-30", "2025-10-27", "2025-11-30", 11, 2025},
+		{"first quarter", timespan.NewBroadcastQuarterWindowEndingOn, "2026-02-10", "2025-12-29", "2026-03-29", 1, 2026},
+		{"late december belongs to next year", timespan.NewBroadcastYearWindowEndingOn, "2025-12-30", "2025-12-29", "2026-12-27", 1, 2026},
+		{"week", timespan.NewBroadcastWeekWindowEndingOn, "2026-03-11", "2026-03-09", "2026-03-15", 11, 2026},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(mustDate(t, tt.input)).Complete()
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+
+			if idx := got.Index(); idx != tt.wantIndex {
+				t.Errorf("index = %d, want %d", idx, tt.wantIndex)
+			}
+			if y := got.(*timespan.BroadcastWindow).BroadcastYear(); y != tt.wantYear {
+				t.Errorf("broadcast year = %d, want %d", y, tt.wantYear)
+			}
+		})
+	}
+}
+
+func TestBroadcastWindow_NextPrev(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         timespan.Window
+		next      bool
+		step      []timespan.Step
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:      "month to date keeps day offset",
+			w:         timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-01-10")),
+			next:      true,
+			wantStart: "2026-01-26",
+			wantEnd:   "2026-02-07",
+		},
+		{
+			name:      "month end moves to next month end",
+			w:         timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-01-25")),
+			next:      true,
+			wantStart: "2026-01-26",
+			wantEnd:   "2026-02-22",
+		},
+		{
+			name:      "month end moves back across year",
+			w:         timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-01-25")),
+			wantStart: "2025-12-01",
+			wantEnd:   "2025-12-28",
+		},
+		{
+			name:      "week year step keeps week number",
+			w:         timespan.NewBroadcastWeekWindowEndingOn(mustDate(t, "2026-03-11")),
+			next:      true,
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2027-03-08",
+			wantEnd:   "2027-03-10",
+		},
+		{
+			name:      "quarter starting",
+			w:         timespan.NewBroadcastQuarterWindowStartingOn(mustDate(t, "2025-12-29")),
+			next:      true,
+			wantStart: "2026-03-30",
+			wantEnd:   "2026-06-28",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.w.Prev(tt.step...)
+			if tt.next {
+				got = tt.w.Next(tt.step...)
+			}
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestWindowEndingOn_Broadcast(t *testing.T) {
+	got, err := timespan.WindowEndingOn(timespan.BroadcastMonth, mustDate(t, "2026-01-10"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertWindow(t, got, mustDate(t, "2025-12-29"), mustDate(t, "2026-01-10"))
+}
@@ -0,0 +1,189 @@
+package timespan
+
+import "time"
+
+// CalendarWeekWindow is a seven day week starting on a configurable weekday.
+// Weeks are numbered US-style: the week containing January 1 is week 1.
+type CalendarWeekWindow struct {
+	start    time.Time
+	end      time.Time
+	anchor   Anchor
+	firstDay time.Weekday
+}
+
+func (w *CalendarWeekWindow) Index() int {
+	_, week := calendarWeekOfYear(w.end, w.firstDay)
+	return week
+}
+
+func (w *CalendarWeekWindow) WeekYear() int {
+	year, _ := calendarWeekOfYear(w.end, w.firstDay)
+	return year
+}
+
+func (w *CalendarWeekWindow) FirstDay() time.Weekday { return w.firstDay }
+
+func (w *CalendarWeekWindow) Period() Period { return calendarWeekPeriod(w.firstDay) }
+
+func (w *CalendarWeekWindow) Validate() error {
+	return validateSpan(w.Period(), w.anchor, w.start, w.end, func(t time.Time) (time.Time, time.Time) {
+		return calendarWeekStart(t, w.firstDay), calendarWeekEnd(t, w.firstDay)
+	})
+}
+
+func (w *CalendarWeekWindow) Start() time.Time { return w.start }
+func (w *CalendarWeekWindow) SetStart(t time.Time) {
+	w.start = t
+}
+
+func (w *CalendarWeekWindow) End() time.Time { return w.end }
+func (w *CalendarWeekWindow) SetEnd(t time.Time) {
+	w.end = t
+}
+
+func (w *CalendarWeekWindow) WithStart(t time.Time) Window {
+	c := *w
+	c.SetStart(t)
+	return &c
+}
+
+func (w *CalendarWeekWindow) WithEnd(t time.Time) Window {
+	c := *w
+	c.SetEnd(t)
+	return &c
+}
+
+func (w *CalendarWeekWindow) Next(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return w.shiftYear(1)
+	}
+
+	return w.shiftWeek(1)
+}
+
+func (w *CalendarWeekWindow) Prev(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return w.shiftYear(-1)
+	}
+
+	return w.shiftWeek(-1)
+}
+
+func (w *CalendarWeekWindow) Complete() Window {
+	ref := w.ref()
+
+	return &CalendarWeekWindow{
+		start:    calendarWeekStart(ref, w.firstDay),
+		end:      calendarWeekEnd(ref, w.firstDay),
+		anchor:   w.anchor,
+		firstDay: w.firstDay,
+	}
+}
+
+func (w *CalendarWeekWindow) ref() time.Time {
+	if w.anchor == StartAnchor {
+		return w.start
+	}
+	return w.end
+}
+
+func (w *CalendarWeekWindow) shiftWeek(delta int) Window {
+	return w.rebuild(w.ref().AddDate(0, 0, 7*delta))
+}
+
+func (w *CalendarWeekWindow) shiftYear(delta int) Window {
+	ref := w.ref()
+	year, week := calendarWeekOfYear(ref, w.firstDay)
+	offset := calendarWeekday(ref, w.firstDay)
+
+	year += delta
+	if weeks := calendarWeeksInYear(year, w.firstDay); week > weeks {
+		week = weeks
+	}
+
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, ref.Location())
+	ref = calendarWeekStart(jan1, w.firstDay).AddDate(0, 0, 7*(week-1)+offset)
+
+	return w.rebuild(ref)
+}
+
+func (w *CalendarWeekWindow) rebuild(ref time.Time) Window {
+	if w.anchor == StartAnchor {
+		return NewCalendarWeekWindowStartingOn(ref, w.firstDay)
+	}
+	return NewCalendarWeekWindowEndingOn(ref, w.firstDay)
+}
+
+func NewCalendarWeekWindowStartingOn(t time.Time, firstDay time.Weekday) Window {
+	return &CalendarWeekWindow{
+		start:    truncateToDay(t),
+		end:      calendarWeekEnd(t, firstDay),
+		anchor:   StartAnchor,
+		firstDay: firstDay,
+	}
+}
+
+func NewCalendarWeekWindowEndingOn(t time.Time, firstDay time.Weekday) Window {
+	return &CalendarWeekWindow{
+		start:    calendarWeekStart(t, firstDay),
+		end:      truncateToDay(t),
+		anchor:   EndAnchor,
+		firstDay: firstDay,
+	}
+}
+
+func calendarWeekPeriod(firstDay time.Weekday) Period {
+	switch firstDay {
+	case time.Sunday:
+		return SundayWeek
+	case time.Monday:
+		return MondayWeek
+	case time.Saturday:
+		return SaturdayWeek
+	default:
+		return CalendarWeek
+	}
+}
+
+func calendarWeekday(t time.Time, firstDay time.Weekday) int {
+	return (int(t.Weekday()) - int(firstDay) + 7) % 7
+}
+
+func calendarWeekStart(t time.Time, firstDay time.Weekday) time.Time {
+	return truncateToDay(t).AddDate(0, 0, -calendarWeekday(t, firstDay))
+}
+
+func calendarWeekEnd(t time.Time, firstDay time.Weekday) time.Time {
+	return calendarWeekStart(t, firstDay).AddDate(0, 0, 6)
+}
+
+// calendarWeekOfYear numbers a week in the year of its last day, so the week
+// containing January 1 is always week 1.
+func calendarWeekOfYear(t time.Time, firstDay time.Weekday) (year, week int) {
+	start := calendarWeekStart(t, firstDay)
+	year = start.AddDate(0, 0, 6).Year()
+
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, t.Location())
+	first := calendarWeekStart(jan1, firstDay)
+
+	return year, daysBetween(first, start)/7 + 1
+}
+
+func calendarWeeksInYear(year int, firstDay time.Weekday) int {
+	nextJan1 := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, week := calendarWeekOfYear(calendarWeekStart(nextJan1, firstDay).AddDate(0, 0, -1), firstDay)
+	return week
+}
+
+// daysBetween counts calendar days from a to b, ignoring DST shifts. It
+// works on civil dates rather than a Duration, which overflows past about
+// 292 years.
+func daysBetween(a, b time.Time) int {
+	return epochDay(b) - epochDay(a)
+}
+
+// epochDay returns the number of days from 1970-01-01 to the civil date of t.
+func epochDay(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60))
+}
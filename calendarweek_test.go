@@ -0,0 +1,126 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestNewCalendarWeekWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		firstDay  time.Weekday
+		anchor    timespan.Anchor
+		wantStart string
+		wantEnd   string
+	}{
+		{"sunday week ending", "2026-03-11", time.Sunday, timespan.EndAnchor, "2026-03-08", "2026-03-11"},
+		{"sunday week starting", "2026-03-11", time.Sunday, timespan.StartAnchor, "2026-03-11", "2026-03-14"},
+		{"saturday week ending on friday", "2026-03-13", time.Saturday, timespan.EndAnchor, "2026-03-07", "2026-03-13"},
+		{"saturday week starting on saturday", "2026-03-14", time.Saturday, timespan.StartAnchor, "2026-03-14", "2026-03-20"},
+		{"monday week ending on sunday", "2026-03-15", time.Monday, timespan.EndAnchor, "2026-03-09", "2026-03-15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn := timespan.NewCalendarWeekWindowEndingOn
+			if tt.anchor == timespan.StartAnchor {
+				fn = timespan.NewCalendarWeekWindowStartingOn
+			}
+
+			got := fn(mustDate(t, tt.input), tt.firstDay)
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestCalendarWeekWindow_Index(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		firstDay time.Weekday
+		wantWeek int
+		wantYear int
+	}{
+		{"week containing jan 1", "2026-01-01", time.Sunday, 1, 2026},
+		{"december days in week of jan 1", "2025-12-29", time.Sunday, 1, 2026},
+		{"second week", "2026-01-04", time.Sunday, 2, 2026},
+		{"last week of year", "2026-12-26", time.Sunday, 52, 2026},
+		{"year with 53 weeks", "2022-12-31", time.Sunday, 53, 2022},
+		{"saturday start", "2026-01-03", time.Saturday, 2, 2026},
+		{"monday start", "2026-03-15", time.Monday, 11, 2026},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := timespan.NewCalendarWeekWindowEndingOn(mustDate(t, tt.input), tt.firstDay).(*timespan.CalendarWeekWindow)
+
+			if got := w.Index(); got != tt.wantWeek {
+				t.Errorf("index = %d, want %d", got, tt.wantWeek)
+			}
+			if got := w.WeekYear(); got != tt.wantYear {
+				t.Errorf("week year = %d, want %d", got, tt.wantYear)
+			}
+		})
+	}
+}
+
+func TestCalendarWeekWindow_NextPrev(t *testing.T) {
+	w := timespan.NewCalendarWeekWindowEndingOn(mustDate(t, "2026-03-11"), time.Sunday)
+
+	assertWindow(t, w.Next(), mustDate(t, "2026-03-15"), mustDate(t, "2026-03-18"))
+	assertWindow(t, w.Prev(), mustDate(t, "2026-03-01"), mustDate(t, "2026-03-04"))
+	assertWindow(t, w.Next(timespan.StepYear), mustDate(t, "2027-03-07"), mustDate(t, "2027-03-10"))
+
+	last := timespan.NewCalendarWeekWindowStartingOn(mustDate(t, "2022-12-25"), time.Sunday)
+	assertWindow(t, last.Next(timespan.StepYear), mustDate(t, "2023-12-24"), mustDate(t, "2023-12-30"))
+}
+
+func TestCalendarWeekWindow_Complete(t *testing.T) {
+	w := timespan.NewCalendarWeekWindowStartingOn(mustDate(t, "2026-03-11"), time.Saturday)
+
+	got := w.Complete()
+
+	assertWindow(t, got, mustDate(t, "2026-03-07"), mustDate(t, "2026-03-13"))
+
+	var days int
+	for range timespan.Days(got) {
+		days++
+	}
+	if days != 7 {
+		t.Errorf("days = %d, want 7", days)
+	}
+	if !timespan.ContainsTime(got, mustDate(t, "2026-03-13")) {
+		t.Errorf("complete week does not contain its last day")
+	}
+}
+
+func TestWindowEndingOn_CalendarWeeks(t *testing.T) {
+	tests := []struct {
+		period    timespan.Period
+		wantStart string
+	}{
+		{timespan.SundayWeek, "2026-03-08"},
+		{timespan.MondayWeek, "2026-03-09"},
+		{timespan.SaturdayWeek, "2026-03-07"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.period), func(t *testing.T) {
+			got, err := timespan.WindowEndingOn(tt.period, mustDate(t, "2026-03-12"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertWindow(t, got, mustDate(t, tt.wantStart), mustDate(t, "2026-03-12"))
+		})
+	}
+}
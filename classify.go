@@ -0,0 +1,84 @@
+package timespan
+
+import "time"
+
+// completePeriods are tried in order when Classify looks for a period that
+// start and end span exactly. Periods with identical windows, such as
+// ISOWeek and MondayWeek, resolve to the first.
+var completePeriods = []Period{
+	Day, ISOWeek, Month, Quarter, Semester, Year,
+	HalfMonth, Week, SundayWeek, SaturdayWeek, MondayWeek,
+	BroadcastWeek, BroadcastMonth, BroadcastQuarter, BroadcastYear,
+}
+
+// partialPeriods are the periods Classify matches partial windows against.
+// Half months and month weeks are left out so the first twelve days of a
+// month read as month to date.
+var partialPeriods = []Period{Day, ISOWeek, Month, Quarter, Semester, Year}
+
+// Classification describes the window Classify matched.
+type Classification struct {
+	Window   Window
+	Period   Period
+	Anchor   Anchor
+	Complete bool
+}
+
+// Classify finds the typed window that start and end describe, so raw
+// ranges can be upgraded from a CustomWindow to a window with the Next and
+// Prev behaviour of its period.
+//
+// A range that spans a whole period, such as 2026-07-01 to 2026-09-30, is
+// that complete window with a StartAnchor. Otherwise a range that starts on
+// a period's first day or ends on its last, such as 2026-03-01 to
+// 2026-03-12 (month to date), is a partial window anchored on its other
+// edge; when several periods fit, the finest wins. Anything else is a
+// CustomWindow.
+//
+// periods restricts the candidates, in order of preference for complete
+// matches. By default complete matches consider every built-in period and
+// partial matches Day, ISOWeek, Month, Quarter, Semester and Year.
+func Classify(start, end time.Time, periods ...Period) (Classification, error) {
+	complete, partial := completePeriods, partialPeriods
+	if len(periods) > 0 {
+		complete, partial = periods, periods
+	}
+	for _, p := range periods {
+		if _, err := lookupPeriod(p); err != nil {
+			return Classification{}, err
+		}
+	}
+
+	for _, p := range complete {
+		if w, ok := exactWindow(p, start, end); ok {
+			return Classification{Window: w, Period: p, Anchor: StartAnchor, Complete: true}, nil
+		}
+	}
+
+	var (
+		best   Classification
+		length int
+	)
+	for _, p := range partial {
+		for _, a := range []Anchor{StartAnchor, EndAnchor} {
+			w, err := NewWindow(p, a, start, end)
+			if err != nil {
+				continue
+			}
+
+			c := w.Complete()
+			if n := daysBetween(c.Start(), c.End()); best.Window == nil || n < length {
+				best, length = Classification{Window: w, Period: p, Anchor: a}, n
+			}
+		}
+	}
+	if best.Window != nil {
+		return best, nil
+	}
+
+	w, err := newCustomWindow(start, end)
+	if err != nil {
+		return Classification{}, err
+	}
+	return Classification{Window: w, Period: Custom, Anchor: StartAnchor}, nil
+}
@@ -0,0 +1,160 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name         string
+		start        string
+		end          string
+		periods      []timespan.Period
+		wantPeriod   timespan.Period
+		wantAnchor   timespan.Anchor
+		wantComplete bool
+	}{
+		{
+			name:         "quarter",
+			start:        "2026-07-01",
+			end:          "2026-09-30",
+			wantPeriod:   timespan.Quarter,
+			wantComplete: true,
+		},
+		{
+			name:         "single day",
+			start:        "2026-03-12",
+			end:          "2026-03-12",
+			wantPeriod:   timespan.Day,
+			wantComplete: true,
+		},
+		{
+			name:         "ISO week rather than Monday week",
+			start:        "2026-03-09",
+			end:          "2026-03-15",
+			wantPeriod:   timespan.ISOWeek,
+			wantComplete: true,
+		},
+		{
+			name:         "half month",
+			start:        "2026-03-16",
+			end:          "2026-03-31",
+			wantPeriod:   timespan.HalfMonth,
+			wantComplete: true,
+		},
+		{
+			name:       "month to date",
+			start:      "2026-03-01",
+			end:        "2026-03-12",
+			wantPeriod: timespan.Month,
+			wantAnchor: timespan.EndAnchor,
+		},
+		{
+			name:       "quarter to date",
+			start:      "2026-01-01",
+			end:        "2026-02-12",
+			wantPeriod: timespan.Quarter,
+			wantAnchor: timespan.EndAnchor,
+		},
+		{
+			name:       "rest of the semester",
+			start:      "2026-09-14",
+			end:        "2026-12-31",
+			wantPeriod: timespan.Semester,
+			wantAnchor: timespan.StartAnchor,
+		},
+		{
+			name:       "rest of the year",
+			start:      "2026-03-14",
+			end:        "2026-12-31",
+			wantPeriod: timespan.Year,
+			wantAnchor: timespan.StartAnchor,
+		},
+		{
+			name:       "rest of the week",
+			start:      "2026-03-11",
+			end:        "2026-03-15",
+			wantPeriod: timespan.ISOWeek,
+			wantAnchor: timespan.StartAnchor,
+		},
+		{
+			name:       "no period fits",
+			start:      "2026-03-10",
+			end:        "2026-03-20",
+			wantPeriod: timespan.Custom,
+		},
+		{
+			name:       "spans two periods",
+			start:      "2026-03-01",
+			end:        "2026-04-12",
+			periods:    []timespan.Period{timespan.Month},
+			wantPeriod: timespan.Custom,
+		},
+		{
+			name:       "restricted periods",
+			start:      "2026-03-01",
+			end:        "2026-03-12",
+			periods:    []timespan.Period{timespan.HalfMonth, timespan.Month},
+			wantPeriod: timespan.HalfMonth,
+			wantAnchor: timespan.EndAnchor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := mustDate(t, tt.start), mustDate(t, tt.end)
+
+			got, err := timespan.Classify(start, end, tt.periods...)
+			if err != nil {
+				t.Fatalf("Classify() error = %v", err)
+			}
+
+			if got.Period != tt.wantPeriod {
+				t.Errorf("Period = %q, want %q", got.Period, tt.wantPeriod)
+			}
+			if got.Anchor != tt.wantAnchor {
+				t.Errorf("Anchor = %v, want %v", got.Anchor, tt.wantAnchor)
+			}
+			if got.Complete != tt.wantComplete {
+				t.Errorf("Complete = %v, want %v", got.Complete, tt.wantComplete)
+			}
+			if p := timespan.PeriodOf(got.Window); p != tt.wantPeriod {
+				t.Errorf("PeriodOf(Window) = %q, want %q", p, tt.wantPeriod)
+			}
+			assertWindow(t, got.Window, start, end)
+		})
+	}
+}
+
+func TestClassify_NextKeepsShape(t *testing.T) {
+	got, err := timespan.Classify(mustDate(t, "2026-03-01"), mustDate(t, "2026-03-12"))
+	if err != nil {
+		t.Fatalf("Classify() error = %v", err)
+	}
+
+	// Month to date on the 12th steps to month to date on the 12th.
+	assertWindow(t, got.Window.Next(), mustDate(t, "2026-04-01"), mustDate(t, "2026-04-12"))
+}
+
+func TestClassify_Errors(t *testing.T) {
+	start, end := mustDate(t, "2026-03-12"), mustDate(t, "2026-03-01")
+
+	if _, err := timespan.Classify(start, end); !errors.Is(err, timespan.ErrEndBeforeStart) {
+		t.Errorf("Classify() error = %v, want ErrEndBeforeStart", err)
+	}
+	if _, err := timespan.Classify(end, start, "decade"); !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("Classify(decade) error = %v, want ErrUnknownPeriod", err)
+	}
+
+	got, err := timespan.Classify(end, time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Classify() error = %v", err)
+	}
+	if got.Period != timespan.Custom {
+		t.Errorf("Period = %q for a time of day, want custom", got.Period)
+	}
+}
@@ -0,0 +1,76 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrNoCover = errors.New("timespan: window cannot be tiled by the given periods")
+
+// coverPeriods are the periods Cover uses when none are given.
+var coverPeriods = []Period{Year, Quarter, Month, ISOWeek, Day}
+
+// Cover returns the fewest complete windows of the given periods that
+// exactly tile the days of w, in order. It defaults to Year, Quarter,
+// Month, ISOWeek and Day. When several tilings are equally short, coarser
+// windows come first, so 2025-11-17 to 2027-02-03 becomes the two ISO
+// weeks up to November 30, December 2025, the year 2026, January 2027 and
+// three days of February. Without Day some windows cannot be tiled; Cover
+// then returns ErrNoCover.
+func Cover(w Window, periods ...Period) ([]Window, error) {
+	if len(periods) == 0 {
+		periods = coverPeriods
+	}
+
+	cs := make([]periodConstructors, len(periods))
+	for i, p := range periods {
+		c, err := lookupPeriod(p)
+		if err != nil {
+			return nil, err
+		}
+		cs[i] = c
+	}
+
+	first, last := truncateToDay(w.Start()), truncateToDay(w.End())
+	if last.Before(first) {
+		return nil, &ValidationError{Period: PeriodOf(w), Start: w.Start(), End: w.End(), Err: ErrEndBeforeStart}
+	}
+	n := daysBetween(first, last) + 1
+
+	// best[i] is the fewest windows tiling day i to the last day and next[i]
+	// the window starting that tiling.
+	best := make([]int, n+1)
+	next := make([]Window, n)
+	for i := n - 1; i >= 0; i-- {
+		day := first.AddDate(0, 0, i)
+		best[i] = -1
+
+		for _, c := range cs {
+			cand := c.startingOn(day)
+			if !c.endingOn(day).Start().Equal(day) || cand.End().After(last) {
+				continue
+			}
+
+			j := i + daysBetween(day, cand.End()) + 1
+			if best[j] < 0 {
+				continue
+			}
+			if best[i] < 0 || best[j]+1 < best[i] ||
+				best[j]+1 == best[i] && cand.End().After(next[i].End()) {
+				best[i], next[i] = best[j]+1, cand
+			}
+		}
+	}
+
+	if best[0] < 0 {
+		return nil, fmt.Errorf("%w: %s to %s", ErrNoCover, first.Format(time.DateOnly), last.Format(time.DateOnly))
+	}
+
+	ws := make([]Window, 0, best[0])
+	for i := 0; i < n; {
+		ws = append(ws, next[i])
+		i += daysBetween(next[i].Start(), next[i].End()) + 1
+	}
+	return ws, nil
+}
@@ -0,0 +1,126 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestCover(t *testing.T) {
+	tests := []struct {
+		name       string
+		window     timespan.Window
+		periods    []timespan.Period
+		want       []span
+		wantPeriod []timespan.Period
+	}{
+		{
+			name:   "default periods",
+			window: timespan.NewCustomWindow(mustDate(t, "2025-11-17"), mustDate(t, "2027-02-03")),
+			want: []span{
+				{"2025-11-17", "2025-11-23"},
+				{"2025-11-24", "2025-11-30"},
+				{"2025-12-01", "2025-12-31"},
+				{"2026-01-01", "2026-12-31"},
+				{"2027-01-01", "2027-01-31"},
+				{"2027-02-01", "2027-02-01"},
+				{"2027-02-02", "2027-02-02"},
+				{"2027-02-03", "2027-02-03"},
+			},
+			wantPeriod: []timespan.Period{
+				timespan.ISOWeek, timespan.ISOWeek, timespan.Month, timespan.Year,
+				timespan.Month, timespan.Day, timespan.Day, timespan.Day,
+			},
+		},
+		{
+			name:    "year, quarter, month and day rollups",
+			window:  timespan.NewCustomWindow(mustDate(t, "2025-11-17"), mustDate(t, "2027-02-03")),
+			periods: []timespan.Period{timespan.Year, timespan.Quarter, timespan.Month, timespan.Day},
+			want: append(daySpans(t, "2025-11-17", 14), []span{
+				{"2025-12-01", "2025-12-31"},
+				{"2026-01-01", "2026-12-31"},
+				{"2027-01-01", "2027-01-31"},
+				{"2027-02-01", "2027-02-01"},
+				{"2027-02-02", "2027-02-02"},
+				{"2027-02-03", "2027-02-03"},
+			}...),
+		},
+		{
+			name:    "quarters beat months",
+			window:  timespan.NewCustomWindow(mustDate(t, "2026-02-01"), mustDate(t, "2026-09-30")),
+			periods: []timespan.Period{timespan.Quarter, timespan.Month},
+			want: []span{
+				{"2026-02-01", "2026-02-28"},
+				{"2026-03-01", "2026-03-31"},
+				{"2026-04-01", "2026-06-30"},
+				{"2026-07-01", "2026-09-30"},
+			},
+		},
+		{
+			name:   "a complete period is a single window",
+			window: timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-04-01")),
+			want:   []span{{"2026-04-01", "2026-06-30"}},
+			wantPeriod: []timespan.Period{
+				timespan.Quarter,
+			},
+		},
+		{
+			name:    "month weeks",
+			window:  timespan.NewMonthWindowStartingOn(mustDate(t, "2026-02-01")),
+			periods: []timespan.Period{timespan.Week},
+			want: []span{
+				{"2026-02-01", "2026-02-07"},
+				{"2026-02-08", "2026-02-14"},
+				{"2026-02-15", "2026-02-21"},
+				{"2026-02-22", "2026-02-28"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timespan.Cover(tt.window, tt.periods...)
+			if err != nil {
+				t.Fatalf("Cover() error = %v", err)
+			}
+			assertSpans(t, got, tt.want)
+
+			for i, p := range tt.wantPeriod {
+				if got := timespan.PeriodOf(got[i]); got != p {
+					t.Errorf("window %d period = %q, want %q", i, got, p)
+				}
+			}
+		})
+	}
+}
+
+func TestCover_Errors(t *testing.T) {
+	w := timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-03-31"))
+
+	if _, err := timespan.Cover(w, timespan.Month, timespan.Quarter); !errors.Is(err, timespan.ErrNoCover) {
+		t.Errorf("Cover() error = %v, want ErrNoCover", err)
+	}
+	if _, err := timespan.Cover(w, "decade"); !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("Cover() error = %v, want ErrUnknownPeriod", err)
+	}
+
+	inverted := mustWithEnd(t, w, mustDate(t, "2026-01-01"))
+	var verr *timespan.ValidationError
+	if _, err := timespan.Cover(inverted); !errors.As(err, &verr) || !errors.Is(err, timespan.ErrEndBeforeStart) {
+		t.Errorf("Cover(end before start) error = %v, want ErrEndBeforeStart", err)
+	}
+}
+
+// daySpans returns n consecutive single-day spans starting on start.
+func daySpans(t *testing.T, start string, n int) []span {
+	t.Helper()
+
+	day := mustDate(t, start)
+	spans := make([]span, n)
+	for i := range spans {
+		d := day.AddDate(0, 0, i).Format("2006-01-02")
+		spans[i] = span{d, d}
+	}
+	return spans
+}
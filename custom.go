@@ -14,6 +14,15 @@ func (c *CustomWindow) Index() int {
 	return 1
 }
 
+func (c *CustomWindow) Period() Period { return Custom }
+
+func (c *CustomWindow) Validate() error {
+	if c.end.Before(c.start) {
+		return &ValidationError{Period: Custom, Start: c.start, End: c.end, Err: ErrEndBeforeStart}
+	}
+	return nil
+}
+
 func (c *CustomWindow) Start() time.Time { return c.start }
 func (c *CustomWindow) SetStart(t time.Time) {
 	c.start = t
@@ -26,6 +35,20 @@ func (c *CustomWindow) SetEnd(t time.Time) {
 	c.shouldEndBeLastDay = isLastDayOfMonth(t)
 }
 
+func (c *CustomWindow) WithStart(t time.Time) Window {
+	cp := *c
+	cp.SetStart(t)
+	cp.duration = cp.end.Sub(cp.start)
+	return &cp
+}
+
+func (c *CustomWindow) WithEnd(t time.Time) Window {
+	cp := *c
+	cp.SetEnd(t)
+	cp.duration = cp.end.Sub(cp.start)
+	return &cp
+}
+
 func (c *CustomWindow) Next(s ...Step) Window {
 	step, ok := GetFirst(s)
 	if ok {
@@ -76,7 +99,8 @@ func (c *CustomWindow) shift(months int) Window {
 }
 
 func (c *CustomWindow) Complete() Window {
-	return c
+	cp := *c
+	return &cp
 }
 
 func (c *CustomWindow) shiftByDuration(delta int) Window {
@@ -94,16 +118,27 @@ func (c *CustomWindow) shiftByDuration(delta int) Window {
 	}
 }
 
+// NewCustomWindow returns the window from start to end. It panics when end
+// is before start; use NewWindow with Custom for untrusted input.
 func NewCustomWindow(start, end time.Time) Window {
-	if end.Before(start) {
+	w, err := newCustomWindow(start, end)
+	if err != nil {
 		panic("custom window end before start")
 	}
 
+	return w
+}
+
+func newCustomWindow(start, end time.Time) (Window, error) {
+	if end.Before(start) {
+		return nil, &ValidationError{Period: Custom, Start: start, End: end, Err: ErrEndBeforeStart}
+	}
+
 	return &CustomWindow{
 		start:                start,
 		end:                  end,
 		duration:             end.Sub(start),
 		shouldStartBeLastDay: isLastDayOfMonth(start),
 		shouldEndBeLastDay:   isLastDayOfMonth(end),
-	}
+	}, nil
 }
@@ -0,0 +1,275 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidDateMath = errors.New("timespan: invalid date math expression")
+
+// dateMathPeriods are the calendar units of date math. Other registered
+// periods are written by name in braces, as in "/{broadcastmonth}".
+var dateMathPeriods = map[string]Period{
+	"y":  Year,
+	"S":  Semester,
+	"Q":  Quarter,
+	"q":  Quarter,
+	"M":  Month,
+	"HM": HalfMonth,
+	"w":  ISOWeek,
+	"d":  Day,
+}
+
+var dateMathMonths = map[Period]int{Year: 12, Semester: 6, Quarter: 3, Month: 1}
+
+// maxDateMathCount bounds N in an offset. Registered periods are stepped one
+// at a time, and larger counts overflow the clock units.
+const maxDateMathCount = 100000
+
+var dateMathClock = map[string]time.Duration{
+	"h": time.Hour,
+	"H": time.Hour,
+	"m": time.Minute,
+	"s": time.Second,
+}
+
+type dateMathOp struct {
+	op   byte
+	n    int
+	unit string
+}
+
+// EvalDateMath evaluates a date math expression such as "now-7d/d" or
+// "2026-01-31||+1M" against ref, which stands for "now". An expression is an
+// anchor followed by offsets (+N unit, -N unit) and roundings (/unit), applied
+// left to right. Rounding moves down to the start of the unit.
+//
+// Units are y (year), S (semester), Q or q (quarter), M (month), HM (half
+// month), w (ISO week), d (day), h or H (hour), m (minute) and s (second).
+// Any registered period can be used by name in braces, as in
+// "now/{broadcastmonth}". Year, semester, quarter and month offsets clamp to
+// the end of shorter months. Offsets are limited to 100000 units.
+func EvalDateMath(expr string, ref time.Time) (time.Time, error) {
+	t, ops, err := parseDateMath(expr, ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, op := range ops {
+		if t, err = op.apply(t); err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q: %v", ErrInvalidDateMath, expr, err)
+		}
+	}
+	return t, nil
+}
+
+// DateMathWindow returns the window an expression ending in a rounding
+// names, such as "now-1M/M" for last month or "now/Q" for this quarter. The
+// last rounding must be to a day or a longer period.
+func DateMathWindow(expr string, ref time.Time) (Window, error) {
+	t, ops, err := parseDateMath(expr, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	last := len(ops) - 1
+	if last < 0 || ops[last].op != '/' {
+		return nil, fmt.Errorf("%w: %q: no rounding to a period", ErrInvalidDateMath, expr)
+	}
+
+	for _, op := range ops[:last] {
+		if t, err = op.apply(t); err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidDateMath, expr, err)
+		}
+	}
+
+	c, err := dateMathPeriod(ops[last].unit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidDateMath, expr, err)
+	}
+	return c.startingOn(c.endingOn(t).Start()), nil
+}
+
+// DateMathRange evaluates a from/to pair the way dashboards do: from rounds
+// down, and a to that ends in a rounding to a day or longer period extends
+// to the last day of that period, as windows do. "now-7d/d" to "now/d"
+// covers the last seven days and today.
+func DateMathRange(from, to string, ref time.Time) (start, end time.Time, err error) {
+	if start, err = EvalDateMath(from, ref); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if w, werr := DateMathWindow(to, ref); werr == nil {
+		end = w.End()
+	} else if end, err = EvalDateMath(to, ref); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, &ValidationError{Period: Custom, Start: start, End: end, Err: ErrEndBeforeStart}
+	}
+	return start, end, nil
+}
+
+func parseDateMath(expr string, ref time.Time) (time.Time, []dateMathOp, error) {
+	fail := func(format string, args ...any) (time.Time, []dateMathOp, error) {
+		return time.Time{}, nil, fmt.Errorf("%w: %q: %s", ErrInvalidDateMath, expr, fmt.Sprintf(format, args...))
+	}
+
+	var (
+		t    time.Time
+		rest string
+	)
+	if r, ok := strings.CutPrefix(expr, "now"); ok {
+		t, rest = ref, r
+	} else if anchor, r, ok := strings.Cut(expr, "||"); ok {
+		a, _, err := parseIntervalTime(anchor, ref.Location())
+		if err != nil {
+			return fail("%v", err)
+		}
+		t, rest = a, r
+	} else {
+		return fail("expected now or a date followed by ||")
+	}
+
+	var ops []dateMathOp
+	for rest != "" {
+		op := dateMathOp{op: rest[0], n: 1}
+		if op.op != '+' && op.op != '-' && op.op != '/' {
+			return fail("unexpected %q", rest)
+		}
+		rest = rest[1:]
+
+		if op.op != '/' {
+			i := 0
+			for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+				i++
+			}
+			if i > 0 {
+				n, err := strconv.Atoi(rest[:i])
+				if err != nil || n > maxDateMathCount {
+					return fail("offset %s out of range", rest[:i])
+				}
+				op.n, rest = n, rest[i:]
+			}
+			if op.op == '-' {
+				op.n = -op.n
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(rest, "{"):
+			end := strings.IndexByte(rest, '}')
+			if end < 0 {
+				return fail("unclosed period name")
+			}
+			op.unit, rest = rest[1:end], rest[end+1:]
+		case strings.HasPrefix(rest, "HM"):
+			op.unit, rest = "HM", rest[2:]
+		case rest != "":
+			op.unit, rest = rest[:1], rest[1:]
+		default:
+			return fail("missing unit")
+		}
+
+		if _, ok := dateMathClock[op.unit]; !ok {
+			if _, err := dateMathPeriod(op.unit); err != nil {
+				return fail("%v", err)
+			}
+		}
+		ops = append(ops, op)
+	}
+
+	return t, ops, nil
+}
+
+func (op dateMathOp) apply(t time.Time) (time.Time, error) {
+	if d, ok := dateMathClock[op.unit]; ok {
+		if op.op == '/' {
+			return roundClock(t, d), nil
+		}
+		return t.Add(time.Duration(op.n) * d), nil
+	}
+
+	c, err := dateMathPeriod(op.unit)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if op.op == '/' {
+		return c.endingOn(t).Start(), nil
+	}
+
+	clock := t.Sub(truncateToDay(t))
+	p := dateMathPeriods[op.unit]
+
+	switch {
+	case dateMathMonths[p] > 0:
+		return addMonthsClamp(t, op.n*dateMathMonths[p]), nil
+	case p == HalfMonth:
+		return addHalfMonths(t, op.n).Add(clock), nil
+	case p == ISOWeek:
+		return t.AddDate(0, 0, 7*op.n), nil
+	case p == Day:
+		return t.AddDate(0, 0, op.n), nil
+	default:
+		bounds := func(t time.Time) (time.Time, time.Time) {
+			return c.endingOn(t).Start(), c.startingOn(t).End()
+		}
+		return shiftSpan(bounds, t, op.n, false).Add(clock), nil
+	}
+}
+
+// addHalfMonths moves the day of t by n half months, two to a month,
+// keeping its offset from the start of the half and clamping it to the end
+// of the target half.
+func addHalfMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	loc := t.Location()
+
+	half, offset := 0, d-1
+	if d > 15 {
+		half, offset = 1, d-16
+	}
+
+	half += n
+	months := half / 2
+	if half%2 < 0 {
+		months--
+	}
+	half -= 2 * months
+
+	start := time.Date(y, m+time.Month(months), 1+15*half, 0, 0, 0, 0, loc)
+	end := halfMonthEnd(start)
+	if t := start.AddDate(0, 0, offset); t.Before(end) {
+		return t
+	}
+	return end
+}
+
+// dateMathPeriod resolves a unit letter or a registered period name.
+func dateMathPeriod(unit string) (periodConstructors, error) {
+	if p, ok := dateMathPeriods[unit]; ok {
+		return lookupPeriod(p)
+	}
+	if unit == "" || len(unit) == 1 {
+		return periodConstructors{}, fmt.Errorf("unknown unit %q", unit)
+	}
+	return lookupPeriod(Period(unit))
+}
+
+func roundClock(t time.Time, d time.Duration) time.Time {
+	y, m, day := t.Date()
+	h, min, s := t.Clock()
+
+	switch d {
+	case time.Hour:
+		min, s = 0, 0
+	case time.Minute:
+		s = 0
+	}
+	return time.Date(y, m, day, h, min, s, 0, t.Location())
+}
@@ -0,0 +1,129 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+var dateMathNow = time.Date(2026, 5, 20, 15, 42, 17, 0, time.UTC)
+
+func TestEvalDateMath(t *testing.T) {
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"now", dateMathNow},
+		{"now-7d/d", time.Date(2026, 5, 13, 0, 0, 0, 0, time.UTC)},
+		{"now-1M/M", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/y", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/q", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/Q", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/S", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"now/HM", time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC)},
+		{"now/w", time.Date(2026, 5, 18, 0, 0, 0, 0, time.UTC)},
+		{"now/h", time.Date(2026, 5, 20, 15, 0, 0, 0, time.UTC)},
+		{"now-90m/m", time.Date(2026, 5, 20, 14, 12, 0, 0, time.UTC)},
+		{"now+1H", dateMathNow.Add(time.Hour)},
+		{"now-30s/s", time.Date(2026, 5, 20, 15, 41, 47, 0, time.UTC)},
+		{"now+1y-2Q", time.Date(2026, 11, 20, 15, 42, 17, 0, time.UTC)},
+		{"now-1HM", time.Date(2026, 5, 5, 15, 42, 17, 0, time.UTC)},
+		{"now+3HM", time.Date(2026, 7, 5, 15, 42, 17, 0, time.UTC)},
+		{"2026-01-31||-3HM", time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)},
+		{"2026-01-15||+3HM", time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"now+20000HM", time.Date(2859, 9, 20, 15, 42, 17, 0, time.UTC)},
+		{"now-1w", time.Date(2026, 5, 13, 15, 42, 17, 0, time.UTC)},
+		{"now-M", time.Date(2026, 4, 20, 15, 42, 17, 0, time.UTC)},
+		{"2026-03-31||-1M", time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"2026-01-31||+1M/d", time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"now/{broadcastmonth}", time.Date(2026, 4, 27, 0, 0, 0, 0, time.UTC)},
+		{"now-1{sundayweek}/{sundayweek}", time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := timespan.EvalDateMath(tt.expr, dateMathNow)
+			if err != nil {
+				t.Fatalf("EvalDateMath(%q): %v", tt.expr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("EvalDateMath(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateMathWindow(t *testing.T) {
+	tests := []struct {
+		expr       string
+		wantPeriod timespan.Period
+		wantStart  string
+		wantEnd    string
+	}{
+		{"now-1M/M", timespan.Month, "2026-04-01", "2026-04-30"},
+		{"now/Q", timespan.Quarter, "2026-04-01", "2026-06-30"},
+		{"now/q", timespan.Quarter, "2026-04-01", "2026-06-30"},
+		{"now-1y/y", timespan.Year, "2025-01-01", "2025-12-31"},
+		{"now+1S/S", timespan.Semester, "2026-07-01", "2026-12-31"},
+		{"now/HM", timespan.HalfMonth, "2026-05-16", "2026-05-31"},
+		{"now-1w/w", timespan.ISOWeek, "2026-05-11", "2026-05-17"},
+		{"now-1d/d", timespan.Day, "2026-05-19", "2026-05-19"},
+		{"now/{broadcastquarter}", timespan.BroadcastQuarter, "2026-03-30", "2026-06-28"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			w, err := timespan.DateMathWindow(tt.expr, dateMathNow)
+			if err != nil {
+				t.Fatalf("DateMathWindow(%q): %v", tt.expr, err)
+			}
+			if got := timespan.PeriodOf(w); got != tt.wantPeriod {
+				t.Errorf("period = %q, want %q", got, tt.wantPeriod)
+			}
+			assertWindow(t, w, mustDate(t, tt.wantStart), mustDate(t, tt.wantEnd))
+			if err := timespan.Validate(w); err != nil {
+				t.Errorf("Validate: %v", err)
+			}
+		})
+	}
+}
+
+func TestDateMathRange(t *testing.T) {
+	start, end, err := timespan.DateMathRange("now-7d/d", "now/d", dateMathNow)
+	if err != nil {
+		t.Fatalf("DateMathRange: %v", err)
+	}
+	if !start.Equal(mustDate(t, "2026-05-13")) || !end.Equal(mustDate(t, "2026-05-20")) {
+		t.Errorf("range = %v - %v, want 2026-05-13 - 2026-05-20", start, end)
+	}
+
+	start, end, err = timespan.DateMathRange("now-6h/h", "now", dateMathNow)
+	if err != nil {
+		t.Fatalf("DateMathRange: %v", err)
+	}
+	if !start.Equal(time.Date(2026, 5, 20, 9, 0, 0, 0, time.UTC)) || !end.Equal(dateMathNow) {
+		t.Errorf("range = %v - %v", start, end)
+	}
+
+	if _, _, err := timespan.DateMathRange("now", "now-1d", dateMathNow); !errors.Is(err, timespan.ErrEndBeforeStart) {
+		t.Errorf("reversed range err = %v, want ErrEndBeforeStart", err)
+	}
+}
+
+func TestDateMath_Errors(t *testing.T) {
+	for _, expr := range []string{"", "today", "now-", "now-1x", "now*2d", "now/{decade}", "now/{month", "2026-13-01||+1d",
+		"now+100001HM", "now+9999999999999999999{broadcastweek}", "now-9223372036854775807h",
+	} {
+		if _, err := timespan.EvalDateMath(expr, dateMathNow); !errors.Is(err, timespan.ErrInvalidDateMath) {
+			t.Errorf("EvalDateMath(%q) err = %v, want ErrInvalidDateMath", expr, err)
+		}
+	}
+
+	for _, expr := range []string{"now", "now-1d", "now/h"} {
+		if _, err := timespan.DateMathWindow(expr, dateMathNow); !errors.Is(err, timespan.ErrInvalidDateMath) {
+			t.Errorf("DateMathWindow(%q) err = %v, want ErrInvalidDateMath", expr, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package timespan
+
+import "time"
+
+// DayWindow is a single calendar day.
+type DayWindow struct {
+	start  time.Time
+	end    time.Time
+	anchor Anchor
+}
+
+func (d *DayWindow) Index() int {
+	return civilIndex(Day, d.end)
+}
+
+func (d *DayWindow) Period() Period { return Day }
+
+func (d *DayWindow) Validate() error {
+	return validateSpan(Day, d.anchor, d.start, d.end, spanOf(truncateToDay, truncateToDay))
+}
+
+func (d *DayWindow) Start() time.Time { return d.start }
+func (d *DayWindow) SetStart(t time.Time) {
+	d.start = t
+}
+
+func (d *DayWindow) End() time.Time { return d.end }
+func (d *DayWindow) SetEnd(t time.Time) {
+	d.end = t
+}
+
+func (d *DayWindow) WithStart(t time.Time) Window {
+	c := *d
+	c.SetStart(t)
+	return &c
+}
+
+func (d *DayWindow) WithEnd(t time.Time) Window {
+	c := *d
+	c.SetEnd(t)
+	return &c
+}
+
+func (d *DayWindow) Next(s ...Step) Window {
+	step, ok := GetFirst(s)
+	if ok {
+		switch step {
+		case StepYear:
+			return d.rebuild(shiftMonthClamp(d.ref(), 12))
+		case StepMonth:
+			return d.rebuild(shiftMonthClamp(d.ref(), 1))
+		}
+	}
+
+	return d.rebuild(d.ref().AddDate(0, 0, 1))
+}
+
+func (d *DayWindow) Prev(s ...Step) Window {
+	step, ok := GetFirst(s)
+	if ok {
+		switch step {
+		case StepYear:
+			return d.rebuild(shiftMonthClamp(d.ref(), -12))
+		case StepMonth:
+			return d.rebuild(shiftMonthClamp(d.ref(), -1))
+		}
+	}
+
+	return d.rebuild(d.ref().AddDate(0, 0, -1))
+}
+
+func (d *DayWindow) Complete() Window {
+	return d.rebuild(d.ref())
+}
+
+func (d *DayWindow) ref() time.Time {
+	if d.anchor == StartAnchor {
+		return d.start
+	}
+	return d.end
+}
+
+func (d *DayWindow) rebuild(t time.Time) Window {
+	if d.anchor == StartAnchor {
+		return NewDayWindowStartingOn(t)
+	}
+	return NewDayWindowEndingOn(t)
+}
+
+func NewDayWindowStartingOn(t time.Time) Window {
+	day := truncateToDay(t)
+	return &DayWindow{start: day, end: day, anchor: StartAnchor}
+}
+
+func NewDayWindowEndingOn(t time.Time) Window {
+	day := truncateToDay(t)
+	return &DayWindow{start: day, end: day, anchor: EndAnchor}
+}
@@ -0,0 +1,48 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestDayWindow_Steps(t *testing.T) {
+	tests := []struct {
+		name  string
+		fn    func(time.Time) timespan.Window
+		input string
+		step  func(timespan.Window) timespan.Window
+		want  string
+	}{
+		{"next", timespan.NewDayWindowEndingOn, "2026-02-28", func(w timespan.Window) timespan.Window { return w.Next() }, "2026-03-01"},
+		{"prev", timespan.NewDayWindowStartingOn, "2026-03-01", func(w timespan.Window) timespan.Window { return w.Prev() }, "2026-02-28"},
+		{"next month clamps", timespan.NewDayWindowEndingOn, "2026-01-31", func(w timespan.Window) timespan.Window { return w.Next(timespan.StepMonth) }, "2026-02-28"},
+		{"prev year clamps", timespan.NewDayWindowStartingOn, "2024-02-29", func(w timespan.Window) timespan.Window { return w.Prev(timespan.StepYear) }, "2023-02-28"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.step(tt.fn(mustDate(t, tt.input)))
+			assertWindow(t, got, mustDate(t, tt.want), mustDate(t, tt.want))
+		})
+	}
+}
+
+func TestDayWindow_TruncatesAndIndexes(t *testing.T) {
+	w := timespan.NewDayWindowStartingOn(time.Date(2026, 2, 3, 18, 30, 0, 0, time.UTC))
+
+	assertWindow(t, w, mustDate(t, "2026-02-03"), mustDate(t, "2026-02-03"))
+	if got := w.Index(); got != 34 {
+		t.Errorf("Index = %d, want 34", got)
+	}
+	if err := timespan.Validate(w); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+
+	viaRegistry, err := timespan.WindowEndingOn(timespan.Day, mustDate(t, "2026-02-03"))
+	if err != nil {
+		t.Fatalf("WindowEndingOn(Day): %v", err)
+	}
+	assertWindow(t, viaRegistry.Complete(), mustDate(t, "2026-02-03"), mustDate(t, "2026-02-03"))
+}
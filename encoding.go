@@ -0,0 +1,529 @@
+package timespan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidEncoding = errors.New("timespan: invalid window encoding")
+
+const binaryVersion byte = 1
+
+// windowState is the canonical form of a window: everything needed to
+// rebuild it exactly, including the flags that steer Next and Prev.
+type windowState struct {
+	Period       Period          `json:"period"`
+	Anchor       string          `json:"anchor,omitempty"`
+	Start        time.Time       `json:"start"`
+	End          time.Time       `json:"end"`
+	Location     string          `json:"location,omitempty"`
+	LastDay      bool            `json:"lastDay,omitempty"`
+	StartLastDay bool            `json:"startLastDay,omitempty"`
+	EndLastDay   bool            `json:"endLastDay,omitempty"`
+	Duration     time.Duration   `json:"duration,omitempty"`
+	FirstDay     *time.Weekday   `json:"firstDay,omitempty"`
+	Fiscal       *FiscalCalendar `json:"fiscal,omitempty"`
+	Retail       *RetailCalendar `json:"retail,omitempty"`
+	YearEnd      *YearEnd        `json:"yearEnd,omitempty"`
+}
+
+func stateOf(w Window) (windowState, error) {
+	s := windowState{
+		Period:   PeriodOf(w),
+		Start:    w.Start(),
+		End:      w.End(),
+		Location: locationName(w.Start().Location()),
+	}
+
+	var anchor Anchor
+	switch v := w.(type) {
+	case *DayWindow:
+		anchor = v.anchor
+	case *WeekWindow:
+		anchor, s.LastDay = v.anchor, v.shouldBeLastDay
+	case *HalfMonthWindow:
+		anchor, s.LastDay = v.anchor, v.shouldBeLastDay
+	case *MonthWindow:
+		anchor, s.LastDay = v.anchor, v.shouldBeLastDay
+	case *QuarterWindow:
+		anchor, s.LastDay = v.anchor, v.shouldBeLastDay
+	case *HalfYearWindow:
+		anchor, s.LastDay = v.anchor, v.shouldBeLastDay
+	case *YearWindow:
+		anchor = v.anchor
+	case *ISOWeekWindow:
+		anchor = v.anchor
+	case *CalendarWeekWindow:
+		anchor, s.FirstDay = v.anchor, &v.firstDay
+	case *FiscalWindow:
+		anchor, s.LastDay, s.Fiscal = v.anchor, v.shouldBeLastDay, &v.calendar
+	case *RetailWindow:
+		anchor, s.LastDay, s.Retail = v.anchor, v.shouldBeLastDay, &v.calendar
+	case *WeekYearWindow:
+		anchor, s.LastDay, s.YearEnd = v.anchor, v.shouldBeLastDay, &v.rule
+	case *BroadcastWindow:
+		anchor, s.LastDay = v.anchor, v.shouldBeLastDay
+	case *ThirteenPeriodWindow:
+		anchor, s.LastDay, s.YearEnd = v.anchor, v.shouldBeLastDay, &v.calendar.YearEnd
+	case *CustomWindow:
+		s.StartLastDay, s.EndLastDay, s.Duration = v.shouldStartBeLastDay, v.shouldEndBeLastDay, v.duration
+		return s, nil
+	default:
+		return s, fmt.Errorf("%w: unsupported window %T", ErrInvalidEncoding, w)
+	}
+
+	s.Anchor = anchorName(anchor)
+	return s, nil
+}
+
+// window rebuilds the window s describes and validates it, so a cached or
+// hand-written payload cannot produce a window the constructors would not.
+func (s windowState) window() (Window, error) {
+	w, err := s.build()
+	if err != nil {
+		return nil, err
+	}
+	if err := Validate(w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (s windowState) build() (Window, error) {
+	if s.Location != "" {
+		s.Start, s.End = inLocation(s.Start, s.Location), inLocation(s.End, s.Location)
+	}
+	if s.End.Before(s.Start) {
+		return nil, &ValidationError{Period: s.Period, Start: s.Start, End: s.End, Err: ErrEndBeforeStart}
+	}
+
+	if s.Period == Custom {
+		return &CustomWindow{
+			start:                s.Start,
+			end:                  s.End,
+			duration:             s.Duration,
+			shouldStartBeLastDay: s.StartLastDay,
+			shouldEndBeLastDay:   s.EndLastDay,
+		}, nil
+	}
+
+	a, ok := parseAnchorName(s.Anchor)
+	if !ok {
+		return nil, fmt.Errorf("%w: anchor %q", ErrInvalidEncoding, s.Anchor)
+	}
+
+	switch s.Period {
+	case Day:
+		return &DayWindow{start: s.Start, end: s.End, anchor: a}, nil
+	case Week:
+		return &WeekWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay}, nil
+	case HalfMonth:
+		return &HalfMonthWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay}, nil
+	case Month:
+		return &MonthWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay}, nil
+	case Quarter:
+		return &QuarterWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay}, nil
+	case Semester:
+		return &HalfYearWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay}, nil
+	case Year:
+		return &YearWindow{start: s.Start, end: s.End, anchor: a}, nil
+	case ISOWeek:
+		return &ISOWeekWindow{start: s.Start, end: s.End, anchor: a}, nil
+	case SundayWeek, MondayWeek, SaturdayWeek, CalendarWeek:
+		if s.FirstDay == nil || *s.FirstDay < time.Sunday || *s.FirstDay > time.Saturday ||
+			calendarWeekPeriod(*s.FirstDay) != s.Period {
+			return nil, fmt.Errorf("%w: first day of %s", ErrInvalidEncoding, s.Period)
+		}
+		return &CalendarWeekWindow{start: s.Start, end: s.End, anchor: a, firstDay: *s.FirstDay}, nil
+	case FiscalMonth, FiscalQuarter, FiscalSemester, FiscalYear:
+		if s.Fiscal == nil {
+			return nil, fmt.Errorf("%w: missing fiscal calendar", ErrInvalidEncoding)
+		}
+		if !s.Fiscal.valid() {
+			return nil, fmt.Errorf("%w: invalid fiscal calendar", ErrInvalidEncoding)
+		}
+		return &FiscalWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay, period: s.Period, calendar: *s.Fiscal}, nil
+	case RetailMonth, RetailQuarter, RetailYear:
+		if s.Retail == nil {
+			return nil, fmt.Errorf("%w: missing retail calendar", ErrInvalidEncoding)
+		}
+		if !s.Retail.valid() {
+			return nil, fmt.Errorf("%w: invalid retail calendar", ErrInvalidEncoding)
+		}
+		return &RetailWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay, period: s.Period, calendar: *s.Retail}, nil
+	case WeekYear:
+		if s.YearEnd == nil {
+			return nil, fmt.Errorf("%w: missing year end rule", ErrInvalidEncoding)
+		}
+		if !s.YearEnd.valid() {
+			return nil, fmt.Errorf("%w: invalid year end rule", ErrInvalidEncoding)
+		}
+		return &WeekYearWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay, rule: *s.YearEnd}, nil
+	case BroadcastWeek, BroadcastMonth, BroadcastQuarter, BroadcastYear:
+		return &BroadcastWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay, period: s.Period}, nil
+	case AccountingPeriod, AccountingYear:
+		if s.YearEnd == nil {
+			return nil, fmt.Errorf("%w: missing year end rule", ErrInvalidEncoding)
+		}
+		if !s.YearEnd.valid() {
+			return nil, fmt.Errorf("%w: invalid year end rule", ErrInvalidEncoding)
+		}
+		cal := ThirteenPeriodCalendar{YearEnd: *s.YearEnd}
+		return &ThirteenPeriodWindow{start: s.Start, end: s.End, anchor: a, shouldBeLastDay: s.LastDay, period: s.Period, calendar: cal}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPeriod, s.Period)
+	}
+}
+
+// values flattens the state into the query-string text form. Nested
+// calendar fields use dotted keys such as fiscal.startMonth.
+func (s windowState) values() url.Values {
+	v := url.Values{}
+
+	setInt := func(key string, n int) { v.Set(key, strconv.Itoa(n)) }
+	setBool := func(key string, b bool) {
+		if b {
+			v.Set(key, "true")
+		}
+	}
+	setYearEnd := func(prefix string, r YearEnd) {
+		setInt(prefix+"month", int(r.Month))
+		setInt(prefix+"weekday", int(r.Weekday))
+		setInt(prefix+"method", int(r.Method))
+		setInt(prefix+"naming", int(r.Naming))
+	}
+
+	v.Set("period", string(s.Period))
+	if s.Anchor != "" {
+		v.Set("anchor", s.Anchor)
+	}
+	v.Set("start", s.Start.Format(time.RFC3339Nano))
+	v.Set("end", s.End.Format(time.RFC3339Nano))
+	if s.Location != "" {
+		v.Set("location", s.Location)
+	}
+
+	setBool("lastDay", s.LastDay)
+	setBool("startLastDay", s.StartLastDay)
+	setBool("endLastDay", s.EndLastDay)
+	if s.Duration != 0 {
+		v.Set("duration", strconv.FormatInt(int64(s.Duration), 10))
+	}
+
+	if s.FirstDay != nil {
+		setInt("firstDay", int(*s.FirstDay))
+	}
+	if s.Fiscal != nil {
+		setInt("fiscal.startMonth", int(s.Fiscal.StartMonth))
+		setInt("fiscal.naming", int(s.Fiscal.Naming))
+	}
+	if s.Retail != nil {
+		setInt("retail.pattern", int(s.Retail.Pattern))
+		setInt("retail.leapWeek", int(s.Retail.LeapWeek))
+		setYearEnd("retail.yearEnd.", s.Retail.YearEnd)
+	}
+	if s.YearEnd != nil {
+		setYearEnd("yearEnd.", *s.YearEnd)
+	}
+
+	return v
+}
+
+func stateFromValues(v url.Values) (windowState, error) {
+	var err error
+
+	getInt := func(key string) int {
+		n, e := strconv.Atoi(v.Get(key))
+		if e != nil && err == nil {
+			err = fmt.Errorf("%w: %s=%q", ErrInvalidEncoding, key, v.Get(key))
+		}
+		return n
+	}
+	getTime := func(key string) time.Time {
+		t, e := time.Parse(time.RFC3339Nano, v.Get(key))
+		if e != nil && err == nil {
+			err = fmt.Errorf("%w: %s=%q", ErrInvalidEncoding, key, v.Get(key))
+		}
+		return t
+	}
+	getYearEnd := func(prefix string) YearEnd {
+		return YearEnd{
+			Month:   time.Month(getInt(prefix + "month")),
+			Weekday: time.Weekday(getInt(prefix + "weekday")),
+			Method:  YearEndMethod(getInt(prefix + "method")),
+			Naming:  FiscalYearNaming(getInt(prefix + "naming")),
+		}
+	}
+
+	s := windowState{
+		Period:       Period(v.Get("period")),
+		Anchor:       v.Get("anchor"),
+		Start:        getTime("start"),
+		End:          getTime("end"),
+		Location:     v.Get("location"),
+		LastDay:      v.Get("lastDay") == "true",
+		StartLastDay: v.Get("startLastDay") == "true",
+		EndLastDay:   v.Get("endLastDay") == "true",
+	}
+
+	if v.Has("duration") {
+		s.Duration = time.Duration(getInt("duration"))
+	}
+	if v.Has("firstDay") {
+		d := time.Weekday(getInt("firstDay"))
+		s.FirstDay = &d
+	}
+	if v.Has("fiscal.startMonth") {
+		s.Fiscal = &FiscalCalendar{
+			StartMonth: time.Month(getInt("fiscal.startMonth")),
+			Naming:     FiscalYearNaming(getInt("fiscal.naming")),
+		}
+	}
+	if v.Has("retail.pattern") {
+		s.Retail = &RetailCalendar{
+			Pattern:  RetailPattern(getInt("retail.pattern")),
+			LeapWeek: LeapWeekRule(getInt("retail.leapWeek")),
+			YearEnd:  getYearEnd("retail.yearEnd."),
+		}
+	}
+	if v.Has("yearEnd.month") {
+		r := getYearEnd("yearEnd.")
+		s.YearEnd = &r
+	}
+
+	return s, err
+}
+
+func anchorName(a Anchor) string {
+	if a == StartAnchor {
+		return "start"
+	}
+	return "end"
+}
+
+func parseAnchorName(s string) (Anchor, bool) {
+	switch s {
+	case "start":
+		return StartAnchor, true
+	case "end":
+		return EndAnchor, true
+	default:
+		return 0, false
+	}
+}
+
+// locationName returns the zone to record for loc, or "" for UTC.
+func locationName(loc *time.Location) string {
+	if loc == time.UTC {
+		return ""
+	}
+	return loc.String()
+}
+
+// inLocation moves t, decoded with the UTC offset it was encoded with, to
+// the named zone. Names that do not load, such as those of fixed zones, and
+// zones that disagree with the encoded offset, such as Local on another
+// machine, keep the encoded offset in a fixed zone of that name, so the
+// wall clock never changes.
+func inLocation(t time.Time, name string) time.Time {
+	_, offset := t.Zone()
+
+	loc := time.Local
+	if name != "Local" {
+		var err error
+		if loc, err = time.LoadLocation(name); err != nil {
+			loc = nil
+		}
+	}
+	if loc != nil {
+		if _, o := t.In(loc).Zone(); o == offset {
+			return t.In(loc)
+		}
+	}
+	return t.In(time.FixedZone(name, offset))
+}
+
+// UnmarshalWindow decodes a window from its JSON, text or binary form and
+// returns the matching concrete type.
+func UnmarshalWindow(data []byte) (Window, error) {
+	var (
+		s   windowState
+		err error
+	)
+
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case len(data) > 0 && data[0] == binaryVersion:
+		s, err = stateFromText(data[1:])
+	case len(trimmed) > 0 && trimmed[0] == '{':
+		if e := json.Unmarshal(data, &s); e != nil {
+			err = fmt.Errorf("%w: %v", ErrInvalidEncoding, e)
+		}
+	default:
+		s, err = stateFromText(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.window()
+}
+
+func stateFromText(data []byte) (windowState, error) {
+	v, err := url.ParseQuery(string(data))
+	if err != nil {
+		return windowState{}, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	return stateFromValues(v)
+}
+
+func marshalJSON(w Window) ([]byte, error) {
+	s, err := stateOf(w)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(s)
+}
+
+func marshalText(w Window) ([]byte, error) {
+	s, err := stateOf(w)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s.values().Encode()), nil
+}
+
+func marshalBinary(w Window) ([]byte, error) {
+	text, err := marshalText(w)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{binaryVersion}, text...), nil
+}
+
+// unmarshalInto decodes data into dst, which must be the concrete type the
+// data describes.
+func unmarshalInto[T any](data []byte, dst *T) error {
+	w, err := UnmarshalWindow(data)
+	if err != nil {
+		return err
+	}
+
+	src, ok := any(w).(*T)
+	if !ok {
+		return fmt.Errorf("%w: %s window into %T", ErrInvalidEncoding, PeriodOf(w), dst)
+	}
+
+	*dst = *src
+	return nil
+}
+
+func (d *DayWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(d) }
+func (d *DayWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, d) }
+func (d *DayWindow) MarshalText() ([]byte, error)      { return marshalText(d) }
+func (d *DayWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, d) }
+func (d *DayWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(d) }
+func (d *DayWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, d) }
+
+func (w *WeekWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(w) }
+func (w *WeekWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, w) }
+func (w *WeekWindow) MarshalText() ([]byte, error)      { return marshalText(w) }
+func (w *WeekWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, w) }
+func (w *WeekWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(w) }
+func (w *WeekWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, w) }
+
+func (w *ISOWeekWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(w) }
+func (w *ISOWeekWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, w) }
+func (w *ISOWeekWindow) MarshalText() ([]byte, error)      { return marshalText(w) }
+func (w *ISOWeekWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, w) }
+func (w *ISOWeekWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(w) }
+func (w *ISOWeekWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, w) }
+
+func (w *CalendarWeekWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(w) }
+func (w *CalendarWeekWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, w) }
+func (w *CalendarWeekWindow) MarshalText() ([]byte, error)      { return marshalText(w) }
+func (w *CalendarWeekWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, w) }
+func (w *CalendarWeekWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(w) }
+func (w *CalendarWeekWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, w) }
+
+func (h *HalfMonthWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(h) }
+func (h *HalfMonthWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, h) }
+func (h *HalfMonthWindow) MarshalText() ([]byte, error)      { return marshalText(h) }
+func (h *HalfMonthWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, h) }
+func (h *HalfMonthWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(h) }
+func (h *HalfMonthWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, h) }
+
+func (m *MonthWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(m) }
+func (m *MonthWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, m) }
+func (m *MonthWindow) MarshalText() ([]byte, error)      { return marshalText(m) }
+func (m *MonthWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, m) }
+func (m *MonthWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(m) }
+func (m *MonthWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, m) }
+
+func (q *QuarterWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(q) }
+func (q *QuarterWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, q) }
+func (q *QuarterWindow) MarshalText() ([]byte, error)      { return marshalText(q) }
+func (q *QuarterWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, q) }
+func (q *QuarterWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(q) }
+func (q *QuarterWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, q) }
+
+func (s *HalfYearWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(s) }
+func (s *HalfYearWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, s) }
+func (s *HalfYearWindow) MarshalText() ([]byte, error)      { return marshalText(s) }
+func (s *HalfYearWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, s) }
+func (s *HalfYearWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(s) }
+func (s *HalfYearWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, s) }
+
+func (y *YearWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(y) }
+func (y *YearWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, y) }
+func (y *YearWindow) MarshalText() ([]byte, error)      { return marshalText(y) }
+func (y *YearWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, y) }
+func (y *YearWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(y) }
+func (y *YearWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, y) }
+
+func (f *FiscalWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(f) }
+func (f *FiscalWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, f) }
+func (f *FiscalWindow) MarshalText() ([]byte, error)      { return marshalText(f) }
+func (f *FiscalWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, f) }
+func (f *FiscalWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(f) }
+func (f *FiscalWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, f) }
+
+func (r *RetailWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(r) }
+func (r *RetailWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, r) }
+func (r *RetailWindow) MarshalText() ([]byte, error)      { return marshalText(r) }
+func (r *RetailWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, r) }
+func (r *RetailWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(r) }
+func (r *RetailWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, r) }
+
+func (w *WeekYearWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(w) }
+func (w *WeekYearWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, w) }
+func (w *WeekYearWindow) MarshalText() ([]byte, error)      { return marshalText(w) }
+func (w *WeekYearWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, w) }
+func (w *WeekYearWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(w) }
+func (w *WeekYearWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, w) }
+
+func (b *BroadcastWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(b) }
+func (b *BroadcastWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, b) }
+func (b *BroadcastWindow) MarshalText() ([]byte, error)      { return marshalText(b) }
+func (b *BroadcastWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, b) }
+func (b *BroadcastWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(b) }
+func (b *BroadcastWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, b) }
+
+func (w *ThirteenPeriodWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(w) }
+func (w *ThirteenPeriodWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, w) }
+func (w *ThirteenPeriodWindow) MarshalText() ([]byte, error)      { return marshalText(w) }
+func (w *ThirteenPeriodWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, w) }
+func (w *ThirteenPeriodWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(w) }
+func (w *ThirteenPeriodWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, w) }
+
+func (c *CustomWindow) MarshalJSON() ([]byte, error)      { return marshalJSON(c) }
+func (c *CustomWindow) UnmarshalJSON(data []byte) error   { return unmarshalInto(data, c) }
+func (c *CustomWindow) MarshalText() ([]byte, error)      { return marshalText(c) }
+func (c *CustomWindow) UnmarshalText(data []byte) error   { return unmarshalInto(data, c) }
+func (c *CustomWindow) MarshalBinary() ([]byte, error)    { return marshalBinary(c) }
+func (c *CustomWindow) UnmarshalBinary(data []byte) error { return unmarshalInto(data, c) }
@@ -0,0 +1,259 @@
+package timespan_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+type encodableWindow interface {
+	timespan.Window
+	json.Marshaler
+	encoding.TextMarshaler
+	encoding.BinaryMarshaler
+}
+
+func encodingFixtures(t *testing.T) map[string]timespan.Window {
+	t.Helper()
+
+	shortFeb := timespan.NewMonthWindowEndingOn(mustDate(t, "2026-01-31")).Next()
+
+	return map[string]timespan.Window{
+		"day":             timespan.NewDayWindowStartingOn(mustDate(t, "2026-01-31")),
+		"week":            timespan.NewWeekWindowEndingOn(mustDate(t, "2026-01-31")),
+		"iso week":        timespan.NewISOWeekWindowStartingOn(mustDate(t, "2026-01-01")),
+		"saturday week":   timespan.NewCalendarWeekWindowEndingOn(mustDate(t, "2026-01-01"), time.Saturday),
+		"wednesday week":  timespan.NewCalendarWeekWindowEndingOn(mustDate(t, "2026-01-01"), time.Wednesday),
+		"half month":      timespan.NewHalfMonthWindowEndingOn(mustDate(t, "2026-02-28")),
+		"month last day":  shortFeb,
+		"quarter":         timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-05-10")),
+		"semester":        timespan.NewSemesterWindowEndingOn(mustDate(t, "2026-06-30")),
+		"year":            timespan.NewYearWindowEndingOn(mustDate(t, "2026-03-15")),
+		"fiscal quarter":  timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-02-28")),
+		"retail month":    timespan.NewRetailMonthWindowEndingOn(nrf, mustDate(t, "2025-04-05")),
+		"week year":       timespan.NewWeekYearWindowStartingOn(nrfYearEnd, mustDate(t, "2025-06-10")),
+		"broadcast month": timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-01-25")),
+		"accounting":      timespan.NewAccountingPeriodWindowEndingOn(thirteen, mustDate(t, "2026-01-10")),
+		"custom":          timespan.NewCustomWindow(mustDate(t, "2026-01-31"), mustDate(t, "2026-02-28").Add(90*time.Minute)),
+	}
+}
+
+func TestWindow_EncodingRoundTrip(t *testing.T) {
+	codecs := map[string]func(encodableWindow) ([]byte, error){
+		"json":   encodableWindow.MarshalJSON,
+		"text":   encodableWindow.MarshalText,
+		"binary": encodableWindow.MarshalBinary,
+	}
+
+	for name, w := range encodingFixtures(t) {
+		for codec, marshal := range codecs {
+			t.Run(name+"/"+codec, func(t *testing.T) {
+				data, err := marshal(w.(encodableWindow))
+				if err != nil {
+					t.Fatalf("marshal: %v", err)
+				}
+
+				got, err := timespan.UnmarshalWindow(data)
+				if err != nil {
+					t.Fatalf("unmarshal %s: %v", data, err)
+				}
+
+				if !reflect.DeepEqual(got, w) {
+					t.Errorf("got %#v, want %#v", got, w)
+				}
+				assertWindow(t, got.Next(), w.Next().Start(), w.Next().End())
+				assertWindow(t, got.Prev(), w.Prev().Start(), w.Prev().End())
+			})
+		}
+	}
+}
+
+func TestWindow_UnmarshalIntoConcreteType(t *testing.T) {
+	w := timespan.NewMonthWindowEndingOn(mustDate(t, "2026-01-31"))
+
+	data, err := json.Marshal(struct {
+		Current timespan.Window `json:"current"`
+	}{w})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var decoded struct {
+		Current *timespan.MonthWindow `json:"current"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+
+	assertWindow(t, decoded.Current.Next(), mustDate(t, "2026-02-01"), mustDate(t, "2026-02-28"))
+
+	var quarter timespan.QuarterWindow
+	err = quarter.UnmarshalText(mustMarshalText(t, w))
+	if !errors.Is(err, timespan.ErrInvalidEncoding) {
+		t.Errorf("month into quarter: err = %v, want ErrInvalidEncoding", err)
+	}
+}
+
+func TestWindow_UnmarshalEveryConcreteType(t *testing.T) {
+	for name, w := range encodingFixtures(t) {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(struct {
+				Current timespan.Window `json:"current"`
+			}{w})
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			// struct{ Current *<concrete type> `json:"current"` }
+			holder := reflect.New(reflect.StructOf([]reflect.StructField{{
+				Name: "Current",
+				Type: reflect.TypeOf(w),
+				Tag:  `json:"current"`,
+			}}))
+			if err := json.Unmarshal(data, holder.Interface()); err != nil {
+				t.Fatalf("json into %T: %v", w, err)
+			}
+			if got := holder.Elem().Field(0).Interface(); !reflect.DeepEqual(got, w) {
+				t.Errorf("json: got %#v, want %#v", got, w)
+			}
+
+			text := reflect.New(reflect.TypeOf(w).Elem()).Interface().(encoding.TextUnmarshaler)
+			if err := text.UnmarshalText(mustMarshalText(t, w)); err != nil {
+				t.Fatalf("text into %T: %v", w, err)
+			}
+			if !reflect.DeepEqual(text, w) {
+				t.Errorf("text: got %#v, want %#v", text, w)
+			}
+
+			bin, err := w.(encoding.BinaryMarshaler).MarshalBinary()
+			if err != nil {
+				t.Fatalf("marshal binary: %v", err)
+			}
+			binary := reflect.New(reflect.TypeOf(w).Elem()).Interface().(encoding.BinaryUnmarshaler)
+			if err := binary.UnmarshalBinary(bin); err != nil {
+				t.Fatalf("binary into %T: %v", w, err)
+			}
+			if !reflect.DeepEqual(binary, w) {
+				t.Errorf("binary: got %#v, want %#v", binary, w)
+			}
+		})
+	}
+}
+
+func TestWindow_EncodingKeepsLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	w := timespan.NewMonthWindowEndingOn(time.Date(2026, 3, 31, 0, 0, 0, 0, loc))
+
+	data, err := w.(encodableWindow).MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	got, err := timespan.UnmarshalWindow(data)
+	if err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if name := got.Start().Location().String(); name != "America/Sao_Paulo" {
+		t.Errorf("location = %q, want America/Sao_Paulo", name)
+	}
+	if !reflect.DeepEqual(got, w) {
+		t.Errorf("got %#v, want %#v", got, w)
+	}
+}
+
+func TestWindow_EncodingKeepsFixedAndLocalZones(t *testing.T) {
+	zones := map[string]*time.Location{
+		"fixed": time.FixedZone("BRT", -3*3600),
+		"local": time.Local,
+	}
+	codecs := map[string]func(encodableWindow) ([]byte, error){
+		"json":   encodableWindow.MarshalJSON,
+		"text":   encodableWindow.MarshalText,
+		"binary": encodableWindow.MarshalBinary,
+	}
+
+	for zone, loc := range zones {
+		w := timespan.NewMonthWindowEndingOn(time.Date(2026, 3, 12, 0, 0, 0, 0, loc))
+
+		for codec, marshal := range codecs {
+			t.Run(zone+"/"+codec, func(t *testing.T) {
+				data, err := marshal(w.(encodableWindow))
+				if err != nil {
+					t.Fatalf("marshal: %v", err)
+				}
+
+				got, err := timespan.UnmarshalWindow(data)
+				if err != nil {
+					t.Fatalf("unmarshal: %v", err)
+				}
+
+				for _, pair := range [][2]time.Time{{got.Start(), w.Start()}, {got.End(), w.End()}} {
+					gotName, gotOffset := pair[0].Zone()
+					wantName, wantOffset := pair[1].Zone()
+					if !pair[0].Equal(pair[1]) || gotName != wantName || gotOffset != wantOffset {
+						t.Errorf("got %v, want %v", pair[0], pair[1])
+					}
+					if pair[0].Location().String() != loc.String() {
+						t.Errorf("location = %q, want %q", pair[0].Location(), loc)
+					}
+				}
+				if !got.Next().Start().Equal(w.Next().Start()) {
+					t.Errorf("Next() = %v, want %v", got.Next().Start(), w.Next().Start())
+				}
+			})
+		}
+	}
+}
+
+func TestUnmarshalWindow_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"unknown period", `{"period":"decade","anchor":"end","start":"2026-01-01T00:00:00Z","end":"2026-01-02T00:00:00Z"}`, timespan.ErrUnknownPeriod},
+		{"bad anchor", `{"period":"month","anchor":"middle","start":"2026-01-01T00:00:00Z","end":"2026-01-02T00:00:00Z"}`, timespan.ErrInvalidEncoding},
+		{"end before start", `{"period":"month","anchor":"end","start":"2026-01-03T00:00:00Z","end":"2026-01-02T00:00:00Z"}`, timespan.ErrEndBeforeStart},
+		{"malformed json", `{"period":`, timespan.ErrInvalidEncoding},
+		{"bad text time", `period=month&anchor=end&start=yesterday&end=2026-01-02T00:00:00Z`, timespan.ErrInvalidEncoding},
+		{"missing calendar", `period=fiscalmonth&anchor=end&start=2026-01-01T00:00:00Z&end=2026-01-02T00:00:00Z`, timespan.ErrInvalidEncoding},
+		{"misaligned month", `{"period":"month","anchor":"start","start":"2026-01-20T00:00:00Z","end":"2027-05-20T00:00:00Z"}`, timespan.ErrMisaligned},
+		{"misaligned fiscal quarter", `{"period":"fiscalquarter","anchor":"end","start":"2026-02-01T00:00:00Z","end":"2026-02-28T00:00:00Z","fiscal":{"startMonth":4,"naming":0}}`, timespan.ErrMisaligned},
+		{"fiscal start month", `{"period":"fiscalquarter","anchor":"end","start":"2026-01-01T00:00:00Z","end":"2026-02-28T00:00:00Z","fiscal":{"startMonth":13,"naming":0}}`, timespan.ErrInvalidEncoding},
+		{"retail pattern", `{"period":"retailmonth","anchor":"end","start":"2025-03-02T00:00:00Z","end":"2025-04-05T00:00:00Z","retail":{"pattern":7,"yearEnd":{"month":1,"weekday":6,"method":1,"naming":1},"leapWeek":0}}`, timespan.ErrInvalidEncoding},
+		{"year end weekday", `{"period":"weekyear","anchor":"start","start":"2025-06-10T00:00:00Z","end":"2026-01-31T00:00:00Z","yearEnd":{"month":1,"weekday":99,"method":1,"naming":1}}`, timespan.ErrInvalidEncoding},
+		{"year end method", `period=accountingperiod&anchor=end&start=2025-12-28T00:00:00Z&end=2026-01-10T00:00:00Z&yearEnd.month=12&yearEnd.weekday=6&yearEnd.method=5&yearEnd.naming=0`, timespan.ErrInvalidEncoding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := timespan.UnmarshalWindow([]byte(tt.data))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if w != nil {
+				t.Errorf("window = %v, want nil", w)
+			}
+		})
+	}
+}
+
+func mustMarshalText(t *testing.T, w timespan.Window) []byte {
+	t.Helper()
+
+	data, err := w.(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		t.Fatalf("marshal text: %v", err)
+	}
+	return data
+}
@@ -0,0 +1,258 @@
+package timespan
+
+import "time"
+
+const (
+	FiscalMonth    Period = "fiscalmonth"
+	FiscalQuarter  Period = "fiscalquarter"
+	FiscalSemester Period = "fiscalsemester"
+	FiscalYear     Period = "fiscalyear"
+)
+
+type FiscalYearNaming int
+
+const (
+	// FiscalYearNamedByEndYear names Apr 2025 - Mar 2026 as FY2026.
+	FiscalYearNamedByEndYear FiscalYearNaming = 0
+	// FiscalYearNamedByStartYear names Apr 2025 - Mar 2026 as FY2025.
+	FiscalYearNamedByStartYear FiscalYearNaming = 1
+)
+
+// FiscalCalendar describes a fiscal year starting on the first day of
+// StartMonth. The zero value is the calendar year.
+type FiscalCalendar struct {
+	StartMonth time.Month       `json:"startMonth"`
+	Naming     FiscalYearNaming `json:"naming"`
+}
+
+// valid reports whether the fields of c are in range. The zero StartMonth
+// is January.
+func (c FiscalCalendar) valid() bool {
+	return c.StartMonth >= 0 && c.StartMonth <= time.December && c.Naming.valid()
+}
+
+func (n FiscalYearNaming) valid() bool {
+	return n == FiscalYearNamedByEndYear || n == FiscalYearNamedByStartYear
+}
+
+func (c FiscalCalendar) startMonth() time.Month {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return time.January
+	}
+	return c.StartMonth
+}
+
+// FiscalYear returns the name of the fiscal year containing t.
+func (c FiscalCalendar) FiscalYear(t time.Time) int {
+	y, m, _ := t.Date()
+
+	start := c.startMonth()
+	if m < start {
+		y--
+	}
+
+	if start != time.January && c.Naming == FiscalYearNamedByEndYear {
+		return y + 1
+	}
+	return y
+}
+
+// fiscalOffset returns how many months t is past the start of its fiscal year.
+func (c FiscalCalendar) fiscalOffset(t time.Time) int {
+	return (int(t.Month()) - int(c.startMonth()) + 12) % 12
+}
+
+// index returns the 1-based position within its fiscal year of the period
+// p containing t.
+func (c FiscalCalendar) index(p Period, t time.Time) int {
+	return c.fiscalOffset(t)/fiscalMonths(p) + 1
+}
+
+func (c FiscalCalendar) periodStart(t time.Time, months int) time.Time {
+	y, m, _ := t.Date()
+	offset := c.fiscalOffset(t)
+
+	first := m - time.Month(offset) + time.Month(offset/months*months)
+	return time.Date(y, first, 1, 0, 0, 0, 0, t.Location())
+}
+
+func (c FiscalCalendar) periodEnd(t time.Time, months int) time.Time {
+	start := c.periodStart(t, months)
+	return time.Date(start.Year(), start.Month()+time.Month(months), 0, 0, 0, 0, 0, t.Location())
+}
+
+func (c FiscalCalendar) isPeriodEnd(t time.Time, months int) bool {
+	return truncateToDay(t).Equal(c.periodEnd(t, months))
+}
+
+// FiscalWindow is a month, quarter, semester or year of a FiscalCalendar.
+type FiscalWindow struct {
+	start           time.Time
+	end             time.Time
+	anchor          Anchor
+	shouldBeLastDay bool
+	period          Period
+	calendar        FiscalCalendar
+}
+
+func (f *FiscalWindow) Index() int {
+	return f.calendar.index(f.period, f.end)
+}
+
+func (f *FiscalWindow) FiscalYear() int {
+	return f.calendar.FiscalYear(f.end)
+}
+
+func (f *FiscalWindow) Period() Period           { return f.period }
+func (f *FiscalWindow) Calendar() FiscalCalendar { return f.calendar }
+
+func (f *FiscalWindow) Validate() error {
+	months := fiscalMonths(f.period)
+
+	return validateSpan(f.period, f.anchor, f.start, f.end, func(t time.Time) (time.Time, time.Time) {
+		return f.calendar.periodStart(t, months), f.calendar.periodEnd(t, months)
+	})
+}
+
+func (f *FiscalWindow) Start() time.Time { return f.start }
+func (f *FiscalWindow) SetStart(t time.Time) {
+	f.start = t
+	if f.anchor == StartAnchor {
+		f.shouldBeLastDay = f.calendar.isPeriodEnd(t, fiscalMonths(f.period))
+	}
+}
+
+func (f *FiscalWindow) End() time.Time { return f.end }
+func (f *FiscalWindow) SetEnd(t time.Time) {
+	f.end = t
+	if f.anchor == EndAnchor {
+		f.shouldBeLastDay = f.calendar.isPeriodEnd(t, fiscalMonths(f.period))
+	}
+}
+
+func (f *FiscalWindow) WithStart(t time.Time) Window {
+	c := *f
+	c.SetStart(t)
+	return &c
+}
+
+func (f *FiscalWindow) WithEnd(t time.Time) Window {
+	c := *f
+	c.SetEnd(t)
+	return &c
+}
+
+func (f *FiscalWindow) Next(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return f.shift(12)
+	}
+
+	return f.shift(fiscalMonths(f.period))
+}
+
+func (f *FiscalWindow) Prev(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return f.shift(-12)
+	}
+
+	return f.shift(-fiscalMonths(f.period))
+}
+
+func (f *FiscalWindow) Complete() Window {
+	ref := f.end
+	if f.anchor == StartAnchor {
+		ref = f.start
+	}
+
+	months := fiscalMonths(f.period)
+
+	return &FiscalWindow{
+		start:           f.calendar.periodStart(ref, months),
+		end:             f.calendar.periodEnd(ref, months),
+		anchor:          f.anchor,
+		shouldBeLastDay: true,
+		period:          f.period,
+		calendar:        f.calendar,
+	}
+}
+
+func (f *FiscalWindow) shift(months int) Window {
+	ref := f.end
+	if f.anchor == StartAnchor {
+		ref = f.start
+	}
+
+	ref = shiftMonthClamp(ref, months)
+
+	if f.shouldBeLastDay {
+		ref = snapToLastDayOfMonth(ref)
+	}
+
+	return newFiscalWindow(f.calendar, f.period, f.anchor, ref)
+}
+
+func NewFiscalMonthWindowStartingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalMonth, StartAnchor, t)
+}
+
+func NewFiscalMonthWindowEndingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalMonth, EndAnchor, t)
+}
+
+func NewFiscalQuarterWindowStartingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalQuarter, StartAnchor, t)
+}
+
+func NewFiscalQuarterWindowEndingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalQuarter, EndAnchor, t)
+}
+
+func NewFiscalSemesterWindowStartingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalSemester, StartAnchor, t)
+}
+
+func NewFiscalSemesterWindowEndingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalSemester, EndAnchor, t)
+}
+
+func NewFiscalYearWindowStartingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalYear, StartAnchor, t)
+}
+
+func NewFiscalYearWindowEndingOn(c FiscalCalendar, t time.Time) Window {
+	return newFiscalWindow(c, FiscalYear, EndAnchor, t)
+}
+
+func newFiscalWindow(c FiscalCalendar, p Period, a Anchor, t time.Time) Window {
+	months := fiscalMonths(p)
+
+	w := &FiscalWindow{
+		start:           c.periodStart(t, months),
+		end:             c.periodEnd(t, months),
+		anchor:          a,
+		shouldBeLastDay: c.isPeriodEnd(t, months),
+		period:          p,
+		calendar:        c,
+	}
+
+	if a == StartAnchor {
+		w.start = truncateToDay(t)
+	} else {
+		w.end = truncateToDay(t)
+	}
+
+	return w
+}
+
+func fiscalMonths(p Period) int {
+	switch p {
+	case FiscalMonth:
+		return 1
+	case FiscalQuarter:
+		return 3
+	case FiscalSemester:
+		return 6
+	default:
+		return 12
+	}
+}
@@ -0,0 +1,208 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+var (
+	fyApril   = timespan.FiscalCalendar{StartMonth: time.April}
+	fyJuly    = timespan.FiscalCalendar{StartMonth: time.July, Naming: timespan.FiscalYearNamedByStartYear}
+	fyOctober = timespan.FiscalCalendar{StartMonth: time.October}
+)
+
+func TestNewFiscalWindows(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		fn        func(timespan.FiscalCalendar, time.Time) timespan.Window
+		cal       timespan.FiscalCalendar
+		wantStart string
+		wantEnd   string
+		wantIndex int
+		wantFY    int
+	}{
+		{
+			name:      "april year ending",
+			input:     "2026-02-10",
+			fn:        timespan.NewFiscalYearWindowEndingOn,
+			cal:       fyApril,
+			wantStart: "2025-04-01",
+			wantEnd:   "2026-02-10",
+			wantIndex: 1,
+			wantFY:    2026,
+		},
+		{
+			name:      "april year starting",
+			input:     "2026-05-10",
+			fn:        timespan.NewFiscalYearWindowStartingOn,
+			cal:       fyApril,
+			wantStart: "2026-05-10",
+			wantEnd:   "2027-03-31",
+			wantIndex: 1,
+			wantFY:    2027,
+		},
+		{
+			name:      "july semester named by start year",
+			input:     "2026-02-10",
+			fn:        timespan.NewFiscalSemesterWindowEndingOn,
+			cal:       fyJuly,
+			wantStart: "2026-01-01",
+			wantEnd:   "2026-02-10",
+			wantIndex: 2,
+			wantFY:    2025,
+		},
+		{
+			name:      "october quarter across new year",
+			input:     "2026-01-20",
+			fn:        timespan.NewFiscalQuarterWindowEndingOn,
+			cal:       fyOctober,
+			wantStart: "2026-01-01",
+			wantEnd:   "2026-01-20",
+			wantIndex: 2,
+			wantFY:    2026,
+		},
+		{
+			name:      "october first quarter",
+			input:     "2025-11-15",
+			fn:        timespan.NewFiscalQuarterWindowStartingOn,
+			cal:       fyOctober,
+			wantStart: "2025-11-15",
+			wantEnd:   "2025-12-31",
+			wantIndex: 1,
+			wantFY:    2026,
+		},
+		{
+			name:      "april month is fiscal period",
+			input:     "2026-03-31",
+			fn:        timespan.NewFiscalMonthWindowEndingOn,
+			cal:       fyApril,
+			wantStart: "2026-03-01",
+			wantEnd:   "2026-03-31",
+			wantIndex: 12,
+			wantFY:    2026,
+		},
+		{
+			name:      "zero calendar is calendar year",
+			input:     "2026-08-01",
+			fn:        timespan.NewFiscalQuarterWindowEndingOn,
+			wantStart: "2026-07-01",
+			wantEnd:   "2026-08-01",
+			wantIndex: 3,
+			wantFY:    2026,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(tt.cal, mustDate(t, tt.input))
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+
+			if idx := got.Index(); idx != tt.wantIndex {
+				t.Errorf("index = %d, want %d", idx, tt.wantIndex)
+			}
+			if fy := got.(*timespan.FiscalWindow).FiscalYear(); fy != tt.wantFY {
+				t.Errorf("fiscal year = %d, want %d", fy, tt.wantFY)
+			}
+		})
+	}
+}
+
+func TestFiscalWindow_NextPrev(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         timespan.Window
+		next      bool
+		step      []timespan.Step
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:      "quarter to date moves to next quarter",
+			w:         timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-05-15")),
+			next:      true,
+			wantStart: "2026-07-01",
+			wantEnd:   "2026-08-15",
+		},
+		{
+			name:      "quarter end preserves last day",
+			w:         timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-06-30")),
+			next:      true,
+			wantStart: "2026-07-01",
+			wantEnd:   "2026-09-30",
+		},
+		{
+			name:      "quarter to date ending on a month end keeps its day",
+			w:         timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-02-28")),
+			next:      true,
+			wantStart: "2026-04-01",
+			wantEnd:   "2026-05-28",
+		},
+		{
+			name:      "quarter to date moved to a month end keeps its day",
+			w:         mustWithEnd(t, timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-02-10")), mustDate(t, "2026-02-28")),
+			next:      true,
+			wantStart: "2026-04-01",
+			wantEnd:   "2026-05-28",
+		},
+		{
+			name:      "quarter to date moved to the quarter end keeps the last day",
+			w:         mustWithEnd(t, timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-02-10")), mustDate(t, "2026-03-31")),
+			next:      true,
+			wantStart: "2026-04-01",
+			wantEnd:   "2026-06-30",
+		},
+		{
+			name:      "quarter to date ending on a month end moves back",
+			w:         timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-02-28")),
+			wantStart: "2025-10-01",
+			wantEnd:   "2025-11-28",
+		},
+		{
+			name:      "year moves back across fiscal boundary",
+			w:         timespan.NewFiscalYearWindowEndingOn(fyJuly, mustDate(t, "2026-02-28")),
+			wantStart: "2024-07-01",
+			wantEnd:   "2025-02-28",
+		},
+		{
+			name:      "semester year step",
+			w:         timespan.NewFiscalSemesterWindowStartingOn(fyOctober, mustDate(t, "2026-04-10")),
+			next:      true,
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2027-04-10",
+			wantEnd:   "2027-09-30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.w.Prev(tt.step...)
+			if tt.next {
+				got = tt.w.Next(tt.step...)
+			}
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestFiscalWindow_Complete(t *testing.T) {
+	w := timespan.NewFiscalSemesterWindowStartingOn(fyOctober, mustDate(t, "2026-02-10"))
+
+	got := w.Complete()
+
+	assertWindow(t, got, mustDate(t, "2025-10-01"), mustDate(t, "2026-03-31"))
+}
@@ -10,11 +10,13 @@ type HalfMonthWindow struct {
 }
 
 func (h *HalfMonthWindow) Index() int {
-	if h.end.Day() >= 16 {
-		return 2
-	}
+	return civilIndex(HalfMonth, h.end)
+}
 
-	return 1
+func (h *HalfMonthWindow) Period() Period { return HalfMonth }
+
+func (h *HalfMonthWindow) Validate() error {
+	return validateSpan(HalfMonth, h.anchor, h.start, h.end, spanOf(halfMonthStart, halfMonthEnd))
 }
 
 func (h *HalfMonthWindow) Start() time.Time { return h.start }
@@ -33,6 +35,18 @@ func (h *HalfMonthWindow) SetEnd(t time.Time) {
 	}
 }
 
+func (h *HalfMonthWindow) WithStart(t time.Time) Window {
+	c := *h
+	c.SetStart(t)
+	return &c
+}
+
+func (h *HalfMonthWindow) WithEnd(t time.Time) Window {
+	c := *h
+	c.SetEnd(t)
+	return &c
+}
+
 func (h *HalfMonthWindow) Next(s ...Step) Window {
 	step, ok := GetFirst(s)
 	if ok {
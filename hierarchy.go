@@ -0,0 +1,144 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrNotNested = errors.New("timespan: window does not nest in the period")
+
+// periodParents and periodChildren describe which periods nest exactly in
+// which. Day has several parents; Parent reports Week.
+var (
+	periodParents = map[Period]Period{
+		Semester:         Year,
+		Quarter:          Semester,
+		Month:            Quarter,
+		HalfMonth:        Month,
+		Week:             Month,
+		Day:              Week,
+		BroadcastQuarter: BroadcastYear,
+		BroadcastMonth:   BroadcastQuarter,
+		BroadcastWeek:    BroadcastMonth,
+	}
+	periodChildren = map[Period][]Period{
+		Year:             {Semester},
+		Semester:         {Quarter},
+		Quarter:          {Month},
+		Month:            {HalfMonth, Week},
+		HalfMonth:        {Day},
+		Week:             {Day},
+		ISOWeek:          {Day},
+		SundayWeek:       {Day},
+		MondayWeek:       {Day},
+		SaturdayWeek:     {Day},
+		BroadcastYear:    {BroadcastQuarter},
+		BroadcastQuarter: {BroadcastMonth},
+		BroadcastMonth:   {BroadcastWeek},
+		BroadcastWeek:    {Day},
+	}
+)
+
+// Parent returns the period p nests in, such as Quarter for Month.
+func (p Period) Parent() (Period, bool) {
+	parent, ok := periodParents[p]
+	return parent, ok
+}
+
+// Children returns the periods that nest in p, such as HalfMonth and Week
+// for Month.
+func (p Period) Children() []Period {
+	return slices.Clone(periodChildren[p])
+}
+
+// Enclosing returns the complete window of period p that contains w, such
+// as the quarter of a month. It fails with ErrNotNested when w spans more
+// than one window of p, as an ISO week crossing two months does.
+func Enclosing(w Window, p Period) (Window, error) {
+	c, err := lookupPeriod(p)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := truncateToDay(w.Start()), truncateToDay(w.End())
+	enclosing := c.startingOn(c.endingOn(first).Start())
+	if last.After(enclosing.End()) {
+		return nil, fmt.Errorf("%w: %q", ErrNotNested, p)
+	}
+	return enclosing, nil
+}
+
+// Parent returns the enclosing window of the parent of w's period.
+func Parent(w Window) (Window, error) {
+	p, ok := PeriodOf(w).Parent()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q has no parent", ErrNotNested, PeriodOf(w))
+	}
+	return Enclosing(w, p)
+}
+
+// Children returns the windows of period p inside w, such as the months of
+// a quarter. p must be a descendant of w's period in the hierarchy; custom
+// windows accept any period. Use Split to divide a window by any period.
+func Children(w Window, p Period) ([]Window, error) {
+	if from := PeriodOf(w); from != Custom && !isDescendant(from, p) {
+		return nil, fmt.Errorf("%w: %q in %q", ErrNotNested, p, from)
+	}
+
+	seq, err := Split(w, p)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Collect(seq), nil
+}
+
+func isDescendant(from, p Period) bool {
+	for _, child := range periodChildren[from] {
+		if child == p || isDescendant(child, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Node is a window and the windows it is divided into.
+type Node struct {
+	Window   Window
+	Children []Node
+}
+
+// Tree returns the hierarchy under w for drill-down menus. Each level of
+// path divides the windows of the level above with Split, clipping windows
+// that cross a boundary, so any path works, such as Semester, Quarter,
+// Month, HalfMonth and Week. Without a path, Tree follows the first child
+// of each period down to Day.
+func Tree(w Window, path ...Period) (Node, error) {
+	if len(path) == 0 {
+		for p := PeriodOf(w); len(periodChildren[p]) > 0; {
+			p = periodChildren[p][0]
+			path = append(path, p)
+		}
+	}
+	return tree(w, path)
+}
+
+func tree(w Window, path []Period) (Node, error) {
+	node := Node{Window: w}
+	if len(path) == 0 {
+		return node, nil
+	}
+
+	seq, err := Split(w, path[0])
+	if err != nil {
+		return Node{}, err
+	}
+	for child := range seq {
+		n, err := tree(child, path[1:])
+		if err != nil {
+			return Node{}, err
+		}
+		node.Children = append(node.Children, n)
+	}
+	return node, nil
+}
@@ -0,0 +1,203 @@
+package timespan_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestPeriod_ParentChildren(t *testing.T) {
+	tests := []struct {
+		period       timespan.Period
+		wantParent   timespan.Period
+		wantOK       bool
+		wantChildren []timespan.Period
+	}{
+		{timespan.Year, "", false, []timespan.Period{timespan.Semester}},
+		{timespan.Quarter, timespan.Semester, true, []timespan.Period{timespan.Month}},
+		{timespan.Month, timespan.Quarter, true, []timespan.Period{timespan.HalfMonth, timespan.Week}},
+		{timespan.Week, timespan.Month, true, []timespan.Period{timespan.Day}},
+		{timespan.ISOWeek, "", false, []timespan.Period{timespan.Day}},
+		{timespan.Day, timespan.Week, true, nil},
+		{timespan.BroadcastWeek, timespan.BroadcastMonth, true, []timespan.Period{timespan.Day}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.period), func(t *testing.T) {
+			parent, ok := tt.period.Parent()
+			if parent != tt.wantParent || ok != tt.wantOK {
+				t.Errorf("Parent() = %q, %v, want %q, %v", parent, ok, tt.wantParent, tt.wantOK)
+			}
+			if got := tt.period.Children(); !slices.Equal(got, tt.wantChildren) {
+				t.Errorf("Children() = %v, want %v", got, tt.wantChildren)
+			}
+		})
+	}
+}
+
+func TestEnclosing(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  timespan.Window
+		period  timespan.Period
+		want    span
+		wantErr error
+	}{
+		{
+			name:   "quarter of a month",
+			window: timespan.NewMonthWindowStartingOn(mustDate(t, "2026-05-01")),
+			period: timespan.Quarter,
+			want:   span{"2026-04-01", "2026-06-30"},
+		},
+		{
+			name:   "year of a partial half month",
+			window: timespan.NewHalfMonthWindowEndingOn(mustDate(t, "2026-08-20")),
+			period: timespan.Year,
+			want:   span{"2026-01-01", "2026-12-31"},
+		},
+		{
+			name:   "month of a custom range",
+			window: timespan.NewCustomWindow(mustDate(t, "2026-02-10"), mustDate(t, "2026-02-12")),
+			period: timespan.Month,
+			want:   span{"2026-02-01", "2026-02-28"},
+		},
+		{
+			name:    "ISO week crossing months",
+			window:  timespan.NewISOWeekWindowStartingOn(mustDate(t, "2026-03-30")),
+			period:  timespan.Month,
+			wantErr: timespan.ErrNotNested,
+		},
+		{
+			name:    "unknown period",
+			window:  timespan.NewMonthWindowStartingOn(mustDate(t, "2026-05-01")),
+			period:  "decade",
+			wantErr: timespan.ErrUnknownPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timespan.Enclosing(tt.window, tt.period)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Enclosing() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Enclosing() error = %v", err)
+			}
+
+			assertWindow(t, got, mustDate(t, tt.want.start), mustDate(t, tt.want.end))
+			if p := timespan.PeriodOf(got); p != tt.period {
+				t.Errorf("period = %q, want %q", p, tt.period)
+			}
+		})
+	}
+}
+
+func TestParent(t *testing.T) {
+	week := timespan.NewWeekWindowStartingOn(mustDate(t, "2026-02-22"))
+
+	month, err := timespan.Parent(week)
+	if err != nil {
+		t.Fatalf("Parent() error = %v", err)
+	}
+	assertWindow(t, month, mustDate(t, "2026-02-01"), mustDate(t, "2026-02-28"))
+
+	year := timespan.NewYearWindowStartingOn(mustDate(t, "2026-01-01"))
+	if _, err := timespan.Parent(year); !errors.Is(err, timespan.ErrNotNested) {
+		t.Errorf("Parent(year) error = %v, want ErrNotNested", err)
+	}
+}
+
+func TestChildren(t *testing.T) {
+	h2 := timespan.NewSemesterWindowStartingOn(mustDate(t, "2026-07-01"))
+
+	months, err := timespan.Children(h2, timespan.Month)
+	if err != nil {
+		t.Fatalf("Children() error = %v", err)
+	}
+	if len(months) != 6 {
+		t.Fatalf("got %d months, want 6", len(months))
+	}
+	assertWindow(t, months[5], mustDate(t, "2026-12-01"), mustDate(t, "2026-12-31"))
+
+	if _, err := timespan.Children(h2, timespan.ISOWeek); !errors.Is(err, timespan.ErrNotNested) {
+		t.Errorf("Children(ISOWeek) error = %v, want ErrNotNested", err)
+	}
+	if _, err := timespan.Children(h2, timespan.Year); !errors.Is(err, timespan.ErrNotNested) {
+		t.Errorf("Children(Year) error = %v, want ErrNotNested", err)
+	}
+}
+
+func TestTree(t *testing.T) {
+	year := timespan.NewYearWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	root, err := timespan.Tree(year)
+	if err != nil {
+		t.Fatalf("Tree() error = %v", err)
+	}
+
+	// Year, semesters, quarters, months, half months, days.
+	counts := map[timespan.Period]int{}
+	var walk func(n timespan.Node)
+	walk = func(n timespan.Node) {
+		counts[timespan.PeriodOf(n.Window)]++
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(root)
+
+	want := map[timespan.Period]int{
+		timespan.Year:      1,
+		timespan.Semester:  2,
+		timespan.Quarter:   4,
+		timespan.Month:     12,
+		timespan.HalfMonth: 24,
+		timespan.Day:       365,
+	}
+	for p, n := range want {
+		if counts[p] != n {
+			t.Errorf("%d %s nodes, want %d", counts[p], p, n)
+		}
+	}
+}
+
+func TestTree_Path(t *testing.T) {
+	month := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-03-01"))
+
+	root, err := timespan.Tree(month, timespan.HalfMonth, timespan.Week)
+	if err != nil {
+		t.Fatalf("Tree() error = %v", err)
+	}
+
+	if len(root.Children) != 2 {
+		t.Fatalf("got %d half months, want 2", len(root.Children))
+	}
+
+	var first []timespan.Window
+	for _, n := range root.Children[0].Children {
+		first = append(first, n.Window)
+		if len(n.Children) != 0 {
+			t.Errorf("leaf %v has %d children", n.Window, len(n.Children))
+		}
+	}
+	assertSpans(t, first, []span{
+		{"2026-03-01", "2026-03-07"},
+		{"2026-03-08", "2026-03-14"},
+		{"2026-03-15", "2026-03-15"},
+	})
+
+	if _, err := timespan.Tree(month, "decade"); !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("Tree(decade) error = %v, want ErrUnknownPeriod", err)
+	}
+
+	custom := timespan.NewCustomWindow(mustDate(t, "2026-03-01"), mustDate(t, "2026-03-05"))
+	if leaf, err := timespan.Tree(custom); err != nil || len(leaf.Children) != 0 {
+		t.Errorf("Tree(custom) = %d children, %v, want a single node", len(leaf.Children), err)
+	}
+}
@@ -0,0 +1,171 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+var ErrInvalidID = errors.New("timespan: invalid period identifier")
+
+type idFormat struct {
+	re    *regexp.Regexp
+	build func(n []int, loc *time.Location) (Window, error)
+}
+
+var idFormats = []idFormat{
+	{regexp.MustCompile(`^(\d{4})$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(Year, n[0], 1, loc)
+	}},
+	{regexp.MustCompile(`^(\d{4})-H([12])$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(Semester, n[0], n[1], loc)
+	}},
+	{regexp.MustCompile(`^(\d{4})-Q([1-4])$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(Quarter, n[0], n[1], loc)
+	}},
+	{regexp.MustCompile(`^(\d{4})-(\d{2})$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(Month, n[0], n[1], loc)
+	}},
+	{regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`), func(n []int, loc *time.Location) (Window, error) {
+		t := time.Date(n[0], time.Month(n[1]), n[2], 0, 0, 0, 0, loc)
+		if !validMonth(n[1]) || t.Day() != n[2] {
+			return nil, errIDRange
+		}
+		return NewDayWindowStartingOn(t), nil
+	}},
+	{regexp.MustCompile(`^(\d{4})-(\d{2})-H([12])$`), func(n []int, loc *time.Location) (Window, error) {
+		if !validMonth(n[1]) {
+			return nil, errIDRange
+		}
+		return NewHalfMonthWindowStartingOn(time.Date(n[0], time.Month(n[1]), 15*n[2]-14, 0, 0, 0, 0, loc)), nil
+	}},
+	{regexp.MustCompile(`^(\d{4})-M(\d{2})-W([1-4])$`), func(n []int, loc *time.Location) (Window, error) {
+		if !validMonth(n[1]) {
+			return nil, errIDRange
+		}
+		return NewWeekWindowStartingOn(time.Date(n[0], time.Month(n[1]), 7*n[2]-6, 0, 0, 0, 0, loc)), nil
+	}},
+	{regexp.MustCompile(`^(\d{4})-W(\d{2})$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(ISOWeek, n[0], n[1], loc)
+	}},
+	{regexp.MustCompile(`^(\d{4})-W(\d{2})-(SUN|MON|TUE|WED|THU|FRI|SAT)$`), func(n []int, loc *time.Location) (Window, error) {
+		firstDay := time.Weekday(n[2])
+		if n[1] < 1 || n[1] > calendarWeeksInYear(n[0], firstDay) {
+			return nil, errIDRange
+		}
+		jan1 := time.Date(n[0], 1, 1, 0, 0, 0, 0, loc)
+		return NewCalendarWeekWindowStartingOn(calendarWeekStart(jan1, firstDay).AddDate(0, 0, 7*(n[1]-1)), firstDay), nil
+	}},
+	{regexp.MustCompile(`^B(\d{4})$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(BroadcastYear, n[0], 1, loc)
+	}},
+	{regexp.MustCompile(`^B(\d{4})-Q([1-4])$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(BroadcastQuarter, n[0], n[1], loc)
+	}},
+	{regexp.MustCompile(`^B(\d{4})-(\d{2})$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(BroadcastMonth, n[0], n[1], loc)
+	}},
+	{regexp.MustCompile(`^B(\d{4})-W(\d{2})$`), func(n []int, loc *time.Location) (Window, error) {
+		return WindowAtInLocation(BroadcastWeek, n[0], n[1], loc)
+	}},
+}
+
+var errIDRange = errors.New("out of range")
+
+var idWeekdays = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// ParseID parses a period identifier into the complete, start-anchored
+// window it names. Dates are built in UTC. Identifiers are:
+//
+//	2026          year
+//	2026-H2       semester
+//	2026-Q3       quarter
+//	2026-01       month
+//	2026-01-H1    half month
+//	2026-01-15    day
+//	2026-M01-W3   week of the month (days 1-7, 8-14, 15-21, 22-end)
+//	2026-W05      ISO week of the ISO year
+//	2026-W05-SUN  calendar week starting on the named weekday
+//	B2026         broadcast year, also B2026-Q1, B2026-01 and B2026-W05
+func ParseID(id string) (Window, error) {
+	return ParseIDInLocation(id, time.UTC)
+}
+
+// ParseIDInLocation is like ParseID but builds the window in loc.
+func ParseIDInLocation(id string, loc *time.Location) (Window, error) {
+	for _, f := range idFormats {
+		m := f.re.FindStringSubmatch(id)
+		if m == nil {
+			continue
+		}
+
+		n := make([]int, len(m)-1)
+		for i, s := range m[1:] {
+			if d := slices.Index(idWeekdays, s); d >= 0 {
+				n[i] = d
+				continue
+			}
+			n[i], _ = strconv.Atoi(s)
+		}
+
+		w, err := f.build(n, loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidID, id, err)
+		}
+		return w, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrInvalidID, id)
+}
+
+// FormatID returns the identifier of the period w falls in. Windows of
+// periods without an identifier, such as custom and fiscal windows, report
+// ErrInvalidID.
+func FormatID(w Window) (string, error) {
+	t := w.Start()
+	y, m, _ := t.Date()
+
+	switch v := w.(type) {
+	case *YearWindow:
+		return fmt.Sprintf("%04d", y), nil
+	case *HalfYearWindow:
+		return fmt.Sprintf("%04d-H%d", y, civilIndex(Semester, t)), nil
+	case *QuarterWindow:
+		return fmt.Sprintf("%04d-Q%d", y, civilIndex(Quarter, t)), nil
+	case *MonthWindow:
+		return fmt.Sprintf("%04d-%02d", y, m), nil
+	case *DayWindow:
+		return t.Format(time.DateOnly), nil
+	case *HalfMonthWindow:
+		return fmt.Sprintf("%04d-%02d-H%d", y, m, halfOfMonth(t)), nil
+	case *WeekWindow:
+		return fmt.Sprintf("%04d-M%02d-W%d", y, m, weekIndex(t)), nil
+	case *ISOWeekWindow:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week), nil
+	case *CalendarWeekWindow:
+		year, week := calendarWeekOfYear(t, v.firstDay)
+		return fmt.Sprintf("%04d-W%02d-%s", year, week, idWeekdays[v.firstDay]), nil
+	case *BroadcastWindow:
+		by, _ := broadcastMonthOf(t)
+		switch v.period {
+		case BroadcastWeek:
+			return fmt.Sprintf("B%04d-W%02d", by, broadcastIndex(BroadcastWeek, t)), nil
+		case BroadcastMonth:
+			return fmt.Sprintf("B%04d-%02d", by, broadcastIndex(BroadcastMonth, t)), nil
+		case BroadcastQuarter:
+			return fmt.Sprintf("B%04d-Q%d", by, broadcastIndex(BroadcastQuarter, t)), nil
+		default:
+			return fmt.Sprintf("B%04d", by), nil
+		}
+	default:
+		return "", fmt.Errorf("%w: no identifier for %s windows", ErrInvalidID, PeriodOf(w))
+	}
+}
+
+func validMonth(m int) bool {
+	return m >= 1 && m <= 12
+}
@@ -0,0 +1,106 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want timespan.Window
+	}{
+		{"2026", timespan.NewYearWindowStartingOn(mustDate(t, "2026-01-01"))},
+		{"2026-H2", timespan.NewSemesterWindowStartingOn(mustDate(t, "2026-07-01"))},
+		{"2026-Q3", timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-07-01"))},
+		{"2026-02", timespan.NewMonthWindowStartingOn(mustDate(t, "2026-02-01"))},
+		{"2026-02-14", timespan.NewDayWindowStartingOn(mustDate(t, "2026-02-14"))},
+		{"2026-02-H2", timespan.NewHalfMonthWindowStartingOn(mustDate(t, "2026-02-16"))},
+		{"2026-M01-W3", timespan.NewWeekWindowStartingOn(mustDate(t, "2026-01-15"))},
+		{"2026-W01", timespan.NewISOWeekWindowStartingOn(mustDate(t, "2025-12-29"))},
+		{"2025-W53", nil},
+		{"2020-W53", timespan.NewISOWeekWindowStartingOn(mustDate(t, "2020-12-28"))},
+		{"2026-W01-SUN", timespan.NewCalendarWeekWindowStartingOn(mustDate(t, "2025-12-28"), time.Sunday)},
+		{"2026-W02-WED", timespan.NewCalendarWeekWindowStartingOn(mustDate(t, "2026-01-07"), time.Wednesday)},
+		{"B2026", timespan.NewBroadcastYearWindowStartingOn(mustDate(t, "2025-12-29"))},
+		{"B2026-Q2", timespan.NewBroadcastQuarterWindowStartingOn(mustDate(t, "2026-03-30"))},
+		{"B2026-02", timespan.NewBroadcastMonthWindowStartingOn(mustDate(t, "2026-01-26"))},
+		{"B2026-W02", timespan.NewBroadcastWeekWindowStartingOn(mustDate(t, "2026-01-05"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := timespan.ParseID(tt.id)
+			if tt.want == nil {
+				if !errors.Is(err, timespan.ErrInvalidID) {
+					t.Fatalf("ParseID(%q) err = %v, want ErrInvalidID", tt.id, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseID(%q): %v", tt.id, err)
+			}
+
+			if timespan.PeriodOf(got) != timespan.PeriodOf(tt.want) {
+				t.Errorf("period = %q, want %q", timespan.PeriodOf(got), timespan.PeriodOf(tt.want))
+			}
+			assertWindow(t, got, tt.want.Start(), tt.want.End())
+			assertWindow(t, got.Next(), tt.want.Next().Start(), tt.want.Next().End())
+
+			id, err := timespan.FormatID(got)
+			if err != nil {
+				t.Fatalf("FormatID: %v", err)
+			}
+			if id != tt.id {
+				t.Errorf("FormatID = %q, want %q", id, tt.id)
+			}
+		})
+	}
+}
+
+func TestFormatID(t *testing.T) {
+	tests := []struct {
+		name string
+		w    timespan.Window
+		want string
+	}{
+		{"partial quarter", timespan.NewQuarterWindowEndingOn(mustDate(t, "2026-08-15")), "2026-Q3"},
+		{"last month week", timespan.NewWeekWindowEndingOn(mustDate(t, "2026-02-28")), "2026-M02-W4"},
+		{"iso week in previous year", timespan.NewISOWeekWindowEndingOn(mustDate(t, "2027-01-01")), "2026-W53"},
+		{"monday week", timespan.NewCalendarWeekWindowEndingOn(mustDate(t, "2026-01-04"), time.Monday), "2026-W01-MON"},
+		{"broadcast month", timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-03-29")), "B2026-03"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timespan.FormatID(tt.w)
+			if err != nil {
+				t.Fatalf("FormatID: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatID = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestID_Errors(t *testing.T) {
+	for _, id := range []string{"", "26", "2026-13", "2026-02-30", "2026-Q5", "2026-H3", "2026-M02-W5", "2026-W00", "2026-W05-XYZ", "B2026-W54"} {
+		if _, err := timespan.ParseID(id); !errors.Is(err, timespan.ErrInvalidID) {
+			t.Errorf("ParseID(%q) err = %v, want ErrInvalidID", id, err)
+		}
+	}
+
+	custom := timespan.NewCustomWindow(mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10"))
+	if _, err := timespan.FormatID(custom); !errors.Is(err, timespan.ErrInvalidID) {
+		t.Errorf("FormatID(custom) err = %v, want ErrInvalidID", err)
+	}
+
+	fiscal := timespan.NewFiscalQuarterWindowStartingOn(fyApril, mustDate(t, "2026-04-01"))
+	if _, err := timespan.FormatID(fiscal); !errors.Is(err, timespan.ErrInvalidID) {
+		t.Errorf("FormatID(fiscal) err = %v, want ErrInvalidID", err)
+	}
+}
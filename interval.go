@@ -0,0 +1,241 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidInterval = errors.New("timespan: invalid ISO 8601 interval")
+
+// intervalPeriods are tried in order when matching a parsed interval to a
+// period, so the coarsest period wins. A Monday to Sunday range on days 1
+// to 7 of a month is both a month week and an ISO week; like Classify, it
+// resolves to ISOWeek.
+var intervalPeriods = []Period{Year, Semester, Quarter, Month, HalfMonth, ISOWeek, Week}
+
+// ParseInterval parses an ISO 8601 interval in one of the forms start/end,
+// start/duration or duration/end, e.g. "2026-01-01/2026-03-31",
+// "2026-01-01/P3M" or "P1M/2026-01-31". Dates without an offset are read
+// in UTC.
+func ParseInterval(s string) (Window, error) {
+	return ParseIntervalInLocation(s, time.UTC)
+}
+
+// ParseIntervalInLocation is like ParseInterval but reads dates without an
+// offset in loc.
+//
+// Date-only intervals name whole days and include their end date, so
+// "2026-01-01/P3M" ends on 2026-03-31. When such an interval covers exactly
+// a year, semester, quarter, month, half month or week, the window of that
+// period is returned; anything else becomes a CustomWindow. Intervals with a
+// time of day are returned as a CustomWindow between the exact instants.
+func ParseIntervalInLocation(s string, loc *time.Location) (Window, error) {
+	fail := func(format string, args ...any) (Window, error) {
+		return nil, fmt.Errorf("%w: %q: %s", ErrInvalidInterval, s, fmt.Sprintf(format, args...))
+	}
+
+	first, second, ok := strings.Cut(s, "/")
+	if !ok {
+		first, second, ok = strings.Cut(s, "--")
+	}
+	if !ok {
+		return fail("missing separator")
+	}
+
+	var (
+		start, end time.Time
+		dateOnly   bool
+	)
+
+	switch {
+	case isDuration(first) && isDuration(second):
+		return fail("two durations")
+	case isDuration(first):
+		d, err := parseISODuration(first)
+		if err != nil {
+			return fail("%v", err)
+		}
+		if end, dateOnly, err = parseIntervalTime(second, loc); err != nil {
+			return fail("%v", err)
+		}
+		if dateOnly {
+			start = d.subtract(end.AddDate(0, 0, 1))
+		} else {
+			start = d.subtract(end)
+		}
+	case isDuration(second):
+		d, err := parseISODuration(second)
+		if err != nil {
+			return fail("%v", err)
+		}
+		if start, dateOnly, err = parseIntervalTime(first, loc); err != nil {
+			return fail("%v", err)
+		}
+		end = d.add(start)
+		if dateOnly {
+			end = end.AddDate(0, 0, -1)
+		}
+	default:
+		var endDateOnly bool
+		var err error
+		if start, dateOnly, err = parseIntervalTime(first, loc); err != nil {
+			return fail("%v", err)
+		}
+		if end, endDateOnly, err = parseIntervalTime(second, loc); err != nil {
+			return fail("%v", err)
+		}
+		dateOnly = dateOnly && endDateOnly
+	}
+
+	if end.Before(start) {
+		return nil, &ValidationError{Period: Custom, Start: start, End: end, Err: ErrEndBeforeStart}
+	}
+
+	if dateOnly {
+		for _, p := range intervalPeriods {
+			if w, ok := exactWindow(p, start, end); ok {
+				return w, nil
+			}
+		}
+	}
+
+	return newCustomWindow(start, end)
+}
+
+// exactWindow returns the window of p when start and end are the first and
+// last day of one of its periods. A partial period is valid from one anchor
+// only, so both are checked.
+func exactWindow(p Period, start, end time.Time) (Window, bool) {
+	w, err := NewWindow(p, StartAnchor, start, end)
+	if err != nil {
+		return nil, false
+	}
+	if _, err := NewWindow(p, EndAnchor, start, end); err != nil {
+		return nil, false
+	}
+	return w, true
+}
+
+// FormatInterval formats w as an ISO 8601 start/end interval. Windows of
+// whole days are written as dates with an inclusive end date, so the result
+// parses back with ParseInterval.
+func FormatInterval(w Window) string {
+	start, end := w.Start(), w.End()
+
+	if isMidnight(start) && isMidnight(end) {
+		return start.Format(time.DateOnly) + "/" + end.Format(time.DateOnly)
+	}
+	return start.Format(time.RFC3339) + "/" + end.Format(time.RFC3339)
+}
+
+// isoDuration is an ISO 8601 duration. Years and months are applied as
+// calendar months, clamping to the end of shorter months.
+type isoDuration struct {
+	months int
+	days   int
+	clock  time.Duration
+}
+
+func (d isoDuration) add(t time.Time) time.Time {
+	return addMonthsClamp(t, d.months).AddDate(0, 0, d.days).Add(d.clock)
+}
+
+func (d isoDuration) subtract(t time.Time) time.Time {
+	return addMonthsClamp(t.Add(-d.clock).AddDate(0, 0, -d.days), -d.months)
+}
+
+func isDuration(s string) bool {
+	return strings.HasPrefix(s, "P")
+}
+
+// parseISODuration parses PnYnMnWnDTnHnMnS with integer components, e.g.
+// "P3M", "P1Y6M", "P2W" or "PT36H".
+func parseISODuration(s string) (isoDuration, error) {
+	var d isoDuration
+
+	rest := strings.TrimPrefix(s, "P")
+	if rest == "" || rest == "T" {
+		return d, fmt.Errorf("empty duration %q", s)
+	}
+
+	inTime := false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return d, fmt.Errorf("duplicate T in duration %q", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return d, fmt.Errorf("malformed duration %q", s)
+		}
+
+		n, err := strconv.Atoi(rest[:i])
+		if err != nil {
+			return d, fmt.Errorf("malformed duration %q", s)
+		}
+
+		switch unit := rest[i]; {
+		case !inTime && unit == 'Y':
+			d.months += 12 * n
+		case !inTime && unit == 'M':
+			d.months += n
+		case !inTime && unit == 'W':
+			d.days += 7 * n
+		case !inTime && unit == 'D':
+			d.days += n
+		case inTime && unit == 'H':
+			d.clock += time.Duration(n) * time.Hour
+		case inTime && unit == 'M':
+			d.clock += time.Duration(n) * time.Minute
+		case inTime && unit == 'S':
+			d.clock += time.Duration(n) * time.Second
+		default:
+			return d, fmt.Errorf("unknown unit %q in duration %q", unit, s)
+		}
+
+		rest = rest[i+1:]
+	}
+
+	return d, nil
+}
+
+// parseIntervalTime parses a date or a date-time and reports whether it was
+// a date only.
+func parseIntervalTime(s string, loc *time.Location) (t time.Time, dateOnly bool, err error) {
+	if t, err = time.ParseInLocation(time.DateOnly, s, loc); err == nil {
+		return t, true, nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err = time.ParseInLocation(layout, s, loc); err == nil {
+			return t, false, nil
+		}
+	}
+
+	return time.Time{}, false, fmt.Errorf("invalid date %q", s)
+}
+
+// addMonthsClamp is shiftMonthClamp that keeps the time of day.
+func addMonthsClamp(t time.Time, months int) time.Time {
+	if months == 0 {
+		return t
+	}
+
+	day := shiftMonthClamp(t, months)
+	return day.Add(t.Sub(truncateToDay(t)))
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Equal(truncateToDay(t))
+}
@@ -0,0 +1,128 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         string
+		wantPeriod timespan.Period
+		wantStart  string
+		wantEnd    string
+	}{
+		{"quarter from dates", "2026-01-01/2026-03-31", timespan.Quarter, "2026-01-01", "2026-03-31"},
+		{"quarter from start and duration", "2026-01-01/P3M", timespan.Quarter, "2026-01-01", "2026-03-31"},
+		{"month from duration and end", "P1M/2026-01-31", timespan.Month, "2026-01-01", "2026-01-31"},
+		{"february from duration and end", "P1M/2026-02-28", timespan.Month, "2026-02-01", "2026-02-28"},
+		{"year", "2026-01-01/P1Y", timespan.Year, "2026-01-01", "2026-12-31"},
+		{"semester", "2026-07-01/2026-12-31", timespan.Semester, "2026-07-01", "2026-12-31"},
+		{"half month", "2026-02-16/2026-02-28", timespan.HalfMonth, "2026-02-16", "2026-02-28"},
+		{"month week", "2026-01-08/P7D", timespan.Week, "2026-01-08", "2026-01-14"},
+		{"iso week", "2026-01-05/P1W", timespan.ISOWeek, "2026-01-05", "2026-01-11"},
+		{"iso week on days 1 to 7", "2026-06-01/2026-06-07", timespan.ISOWeek, "2026-06-01", "2026-06-07"},
+		{"unaligned span", "2026-01-10/2026-02-09", timespan.Custom, "2026-01-10", "2026-02-09"},
+		{"single day", "2026-01-10/P1D", timespan.Custom, "2026-01-10", "2026-01-10"},
+		{"double hyphen separator", "2026-04-01--2026-06-30", timespan.Quarter, "2026-04-01", "2026-06-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := timespan.ParseInterval(tt.in)
+			if err != nil {
+				t.Fatalf("ParseInterval(%q): %v", tt.in, err)
+			}
+
+			if got := timespan.PeriodOf(w); got != tt.wantPeriod {
+				t.Errorf("period = %q, want %q", got, tt.wantPeriod)
+			}
+			assertWindow(t, w, mustDate(t, tt.wantStart), mustDate(t, tt.wantEnd))
+		})
+	}
+}
+
+func TestParseInterval_DateTime(t *testing.T) {
+	w, err := timespan.ParseInterval("2026-01-01T08:00:00Z/PT36H")
+	if err != nil {
+		t.Fatalf("ParseInterval: %v", err)
+	}
+
+	if got := timespan.PeriodOf(w); got != timespan.Custom {
+		t.Errorf("period = %q, want custom", got)
+	}
+	assertWindow(t, w,
+		time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 2, 20, 0, 0, 0, time.UTC),
+	)
+}
+
+func TestParseIntervalInLocation(t *testing.T) {
+	loc := time.FixedZone("UTC-3", -3*60*60)
+
+	w, err := timespan.ParseIntervalInLocation("2026-01-01/P3M", loc)
+	if err != nil {
+		t.Fatalf("ParseIntervalInLocation: %v", err)
+	}
+
+	assertWindow(t, w, time.Date(2026, 1, 1, 0, 0, 0, 0, loc), time.Date(2026, 3, 31, 0, 0, 0, 0, loc))
+}
+
+func TestParseInterval_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr error
+	}{
+		{"no separator", "2026-01-01", timespan.ErrInvalidInterval},
+		{"two durations", "P1M/P2M", timespan.ErrInvalidInterval},
+		{"bad date", "2026-13-01/2026-12-31", timespan.ErrInvalidInterval},
+		{"bad duration", "2026-01-01/P1X", timespan.ErrInvalidInterval},
+		{"empty duration", "2026-01-01/P", timespan.ErrInvalidInterval},
+		{"end before start", "2026-03-31/2026-01-01", timespan.ErrEndBeforeStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := timespan.ParseInterval(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseInterval(%q) err = %v, want %v", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	tests := []struct {
+		name string
+		w    timespan.Window
+		want string
+	}{
+		{"month", timespan.NewMonthWindowEndingOn(mustDate(t, "2026-02-28")), "2026-02-01/2026-02-28"},
+		{"partial quarter", timespan.NewQuarterWindowEndingOn(mustDate(t, "2026-02-15")), "2026-01-01/2026-02-15"},
+		{"fiscal year", timespan.NewFiscalYearWindowStartingOn(fyApril, mustDate(t, "2025-04-01")), "2025-04-01/2026-03-31"},
+		{"custom with time", timespan.NewCustomWindow(
+			time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 2, 20, 0, 0, 0, time.UTC),
+		), "2026-01-01T08:00:00Z/2026-01-02T20:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timespan.FormatInterval(tt.w)
+			if got != tt.want {
+				t.Errorf("FormatInterval = %q, want %q", got, tt.want)
+			}
+
+			back, err := timespan.ParseInterval(got)
+			if err != nil {
+				t.Fatalf("ParseInterval(%q): %v", got, err)
+			}
+			assertWindow(t, back, tt.w.Start(), tt.w.End())
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package timespan
+
+import "time"
+
+// ISOWeekWindow is an ISO 8601 week: Monday to Sunday, where week 1 is the
+// week containing the first Thursday of the ISO year.
+type ISOWeekWindow struct {
+	start  time.Time
+	end    time.Time
+	anchor Anchor
+}
+
+func (w *ISOWeekWindow) Index() int {
+	_, week := w.end.ISOWeek()
+	return week
+}
+
+func (w *ISOWeekWindow) ISOYear() int {
+	year, _ := w.end.ISOWeek()
+	return year
+}
+
+func (w *ISOWeekWindow) Period() Period { return ISOWeek }
+
+func (w *ISOWeekWindow) Validate() error {
+	return validateSpan(ISOWeek, w.anchor, w.start, w.end, spanOf(isoWeekStart, isoWeekEnd))
+}
+
+func (w *ISOWeekWindow) Start() time.Time { return w.start }
+func (w *ISOWeekWindow) SetStart(t time.Time) {
+	w.start = t
+}
+
+func (w *ISOWeekWindow) End() time.Time { return w.end }
+func (w *ISOWeekWindow) SetEnd(t time.Time) {
+	w.end = t
+}
+
+func (w *ISOWeekWindow) WithStart(t time.Time) Window {
+	c := *w
+	c.SetStart(t)
+	return &c
+}
+
+func (w *ISOWeekWindow) WithEnd(t time.Time) Window {
+	c := *w
+	c.SetEnd(t)
+	return &c
+}
+
+func (w *ISOWeekWindow) Next(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return w.shiftYear(1)
+	}
+
+	return w.shiftWeek(1)
+}
+
+func (w *ISOWeekWindow) Prev(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return w.shiftYear(-1)
+	}
+
+	return w.shiftWeek(-1)
+}
+
+func (w *ISOWeekWindow) Complete() Window {
+	ref := w.end
+	if w.anchor == StartAnchor {
+		ref = w.start
+	}
+
+	return &ISOWeekWindow{
+		start:  isoWeekStart(ref),
+		end:    isoWeekEnd(ref),
+		anchor: w.anchor,
+	}
+}
+
+func (w *ISOWeekWindow) ref() time.Time {
+	if w.anchor == StartAnchor {
+		return w.start
+	}
+	return w.end
+}
+
+func (w *ISOWeekWindow) shiftWeek(delta int) Window {
+	ref := w.ref().AddDate(0, 0, 7*delta)
+
+	if w.anchor == StartAnchor {
+		return NewISOWeekWindowStartingOn(ref)
+	}
+	return NewISOWeekWindowEndingOn(ref)
+}
+
+// shiftYear keeps the week number and weekday, falling back to week 52 when
+// the target ISO year has no week 53.
+func (w *ISOWeekWindow) shiftYear(delta int) Window {
+	ref := w.ref()
+	year, week := ref.ISOWeek()
+	offset := isoWeekday(ref)
+
+	year += delta
+	if weeks := isoWeeksInYear(year); week > weeks {
+		week = weeks
+	}
+
+	ref = isoWeekDate(year, week, ref.Location()).AddDate(0, 0, offset)
+
+	if w.anchor == StartAnchor {
+		return NewISOWeekWindowStartingOn(ref)
+	}
+	return NewISOWeekWindowEndingOn(ref)
+}
+
+func NewISOWeekWindowStartingOn(t time.Time) Window {
+	return &ISOWeekWindow{
+		start:  truncateToDay(t),
+		end:    isoWeekEnd(t),
+		anchor: StartAnchor,
+	}
+}
+
+func NewISOWeekWindowEndingOn(t time.Time) Window {
+	return &ISOWeekWindow{
+		start:  isoWeekStart(t),
+		end:    truncateToDay(t),
+		anchor: EndAnchor,
+	}
+}
+
+// isoWeekday returns the zero-based day of the ISO week, Monday being 0.
+func isoWeekday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func isoWeekStart(t time.Time) time.Time {
+	return truncateToDay(t).AddDate(0, 0, -isoWeekday(t))
+}
+
+func isoWeekEnd(t time.Time) time.Time {
+	return isoWeekStart(t).AddDate(0, 0, 6)
+}
+
+// isoWeekDate returns the Monday of the given ISO week.
+func isoWeekDate(year, week int, loc *time.Location) time.Time {
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	return isoWeekStart(jan4).AddDate(0, 0, 7*(week-1))
+}
+
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
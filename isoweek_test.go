@@ -0,0 +1,198 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestNewISOWeekWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     time.Time
+		wantStart string
+		wantEnd   string
+		fn        func(time.Time) timespan.Window
+	}{
+		{
+			name:      "ending mid week",
+			input:     mustDate(t, "2026-03-11"),
+			wantStart: "2026-03-09",
+			wantEnd:   "2026-03-11",
+			fn:        timespan.NewISOWeekWindowEndingOn,
+		},
+		{
+			name:      "ending on sunday",
+			input:     mustDate(t, "2026-03-15"),
+			wantStart: "2026-03-09",
+			wantEnd:   "2026-03-15",
+			fn:        timespan.NewISOWeekWindowEndingOn,
+		},
+		{
+			name:      "starting mid week",
+			input:     mustDate(t, "2026-03-11"),
+			wantStart: "2026-03-11",
+			wantEnd:   "2026-03-15",
+			fn:        timespan.NewISOWeekWindowStartingOn,
+		},
+		{
+			name:      "starting across new year",
+			input:     mustDate(t, "2025-12-30"),
+			wantStart: "2025-12-30",
+			wantEnd:   "2026-01-04",
+			fn:        timespan.NewISOWeekWindowStartingOn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(tt.input)
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestISOWeekWindow_Index(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantWeek int
+		wantYear int
+	}{
+		{"first thursday week", "2026-01-01", 1, 2026},
+		{"december belongs to next iso year", "2025-12-29", 1, 2026},
+		{"january belongs to previous iso year", "2027-01-02", 53, 2026},
+		{"week 53", "2026-12-31", 53, 2026},
+		{"mid year", "2026-03-11", 11, 2026},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := timespan.NewISOWeekWindowEndingOn(mustDate(t, tt.input)).(*timespan.ISOWeekWindow)
+
+			if got := w.Index(); got != tt.wantWeek {
+				t.Errorf("index = %d, want %d", got, tt.wantWeek)
+			}
+			if got := w.ISOYear(); got != tt.wantYear {
+				t.Errorf("iso year = %d, want %d", got, tt.wantYear)
+			}
+		})
+	}
+}
+
+func TestISOWeekWindow_Next(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     time.Time
+		step      []timespan.Step
+		wantStart string
+		wantEnd   string
+		fn        func(time.Time) timespan.Window
+	}{
+		{
+			name:      "ending keeps weekday",
+			input:     mustDate(t, "2026-03-11"),
+			wantStart: "2026-03-16",
+			wantEnd:   "2026-03-18",
+			fn:        timespan.NewISOWeekWindowEndingOn,
+		},
+		{
+			name:      "starting crosses new year",
+			input:     mustDate(t, "2025-12-24"),
+			wantStart: "2025-12-31",
+			wantEnd:   "2026-01-04",
+			fn:        timespan.NewISOWeekWindowStartingOn,
+		},
+		{
+			name:      "year step keeps week number",
+			input:     mustDate(t, "2026-03-11"),
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2027-03-15",
+			wantEnd:   "2027-03-17",
+			fn:        timespan.NewISOWeekWindowEndingOn,
+		},
+		{
+			name:      "year step from week 53 falls back to week 52",
+			input:     mustDate(t, "2026-12-31"),
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2027-12-27",
+			wantEnd:   "2027-12-30",
+			fn:        timespan.NewISOWeekWindowEndingOn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := tt.fn(tt.input)
+			got := w.Next(tt.step...)
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestISOWeekWindow_Prev(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     time.Time
+		step      []timespan.Step
+		wantStart string
+		wantEnd   string
+		fn        func(time.Time) timespan.Window
+	}{
+		{
+			name:      "ending crosses new year",
+			input:     mustDate(t, "2026-01-02"),
+			wantStart: "2025-12-22",
+			wantEnd:   "2025-12-26",
+			fn:        timespan.NewISOWeekWindowEndingOn,
+		},
+		{
+			name:      "year step keeps week number",
+			input:     mustDate(t, "2026-03-11"),
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2025-03-12",
+			wantEnd:   "2025-03-16",
+			fn:        timespan.NewISOWeekWindowStartingOn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := tt.fn(tt.input)
+			got := w.Prev(tt.step...)
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestISOWeekWindow_Complete(t *testing.T) {
+	w := timespan.NewISOWeekWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	got := w.Complete()
+
+	assertWindow(
+		t,
+		got,
+		mustDate(t, "2025-12-29"),
+		mustDate(t, "2026-01-04"),
+	)
+}
@@ -0,0 +1,278 @@
+package timespan
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format renders w in English; see Locale.Format.
+func Format(w Window, layout string) string {
+	return english.Format(w, layout)
+}
+
+// Label names w in English; see Locale.Label.
+func Label(w Window) string {
+	return english.Label(w)
+}
+
+// Format renders w using a layout of brace-delimited tokens. Text outside
+// braces is copied as is and "{{" writes a literal brace.
+//
+// Date tokens refer to the window's end, or to its start or end when
+// prefixed with "s." or "e.", as in "{s.MMM} {s.D} - {e.MMM} {e.D}":
+//
+//	{YYYY} {YY}             year
+//	{M} {MM} {MMM} {MMMM}   month as 1, 01, Jan, January
+//	{D} {DD} {Do}           day as 1, 01, 1st
+//	{ddd} {dddd}            weekday as Mon, Monday
+//
+// Period tokens are computed in the window's own calendar, so {Q} is the
+// fiscal quarter of a FiscalWindow:
+//
+//	{Q}      quarter of the year
+//	{S}      semester of the year
+//	{H}      half of the month
+//	{W}      week of the month (days 1-7, 8-14, 15-21, 22-end)
+//	{WW}     week of the week-based year: ISO, calendar or broadcast week
+//	{WYYYY}  year the week belongs to
+//	{P}      number of the window's period within its year
+//	{FY}     fiscal, retail or broadcast year; the calendar year otherwise
+//
+// D, Q, S, H, W and P take an "o" suffix for an ordinal, as in {Qo}, or an
+// "a" suffix for the feminine ordinal, as in "{Ha} quinzena". Unknown tokens
+// are written unchanged.
+func (l Locale) Format(w Window, layout string) string {
+	return l.format(w, layout, w.End())
+}
+
+// Label returns the default name of w, such as "Q1 2026", "March 2026" or
+// "1st half of March 2026". Custom windows, partial windows and windows of
+// periods without a layout are labelled with their date range, such as
+// "Jan 10 – Jan 20, 2026".
+func (l Locale) Label(w Window) string {
+	if layout, ok := l.Layouts[PeriodOf(w)]; ok && isComplete(w) {
+		return l.Format(w, layout)
+	}
+	return l.rangeLabel(w)
+}
+
+func isComplete(w Window) bool {
+	if PeriodOf(w) == Custom {
+		return false
+	}
+
+	c := w.Complete()
+	return c.Start().Equal(w.Start()) && c.End().Equal(w.End())
+}
+
+func (l Locale) rangeLabel(w Window) string {
+	start, end := w.Start(), w.End()
+
+	switch {
+	case truncateToDay(start).Equal(truncateToDay(end)):
+		return l.format(w, l.DateLayout, start)
+	case start.Year() == end.Year():
+		return l.format(w, l.ShortDateLayout, start) + l.RangeSeparator + l.format(w, l.DateLayout, end)
+	default:
+		return l.format(w, l.DateLayout, start) + l.RangeSeparator + l.format(w, l.DateLayout, end)
+	}
+}
+
+// format renders layout with unprefixed date tokens referring to t.
+func (l Locale) format(w Window, layout string, t time.Time) string {
+	var b strings.Builder
+
+	for {
+		i := strings.IndexByte(layout, '{')
+		if i < 0 {
+			b.WriteString(layout)
+			return b.String()
+		}
+		b.WriteString(layout[:i])
+		layout = layout[i:]
+
+		if strings.HasPrefix(layout, "{{") {
+			b.WriteByte('{')
+			layout = layout[2:]
+			continue
+		}
+
+		j := strings.IndexByte(layout, '}')
+		if j < 0 {
+			b.WriteString(layout)
+			return b.String()
+		}
+
+		if v, ok := l.formatToken(w, layout[1:j], t); ok {
+			b.WriteString(v)
+		} else {
+			b.WriteString(layout[:j+1])
+		}
+		layout = layout[j+1:]
+	}
+}
+
+func (l Locale) formatToken(w Window, token string, t time.Time) (string, bool) {
+	if rest, ok := strings.CutPrefix(token, "s."); ok {
+		t, token = w.Start(), rest
+	} else if rest, ok := strings.CutPrefix(token, "e."); ok {
+		t, token = w.End(), rest
+	}
+
+	switch token {
+	case "YYYY":
+		return strconv.Itoa(t.Year()), true
+	case "YY":
+		return pad2(t.Year() % 100), true
+	case "M":
+		return strconv.Itoa(int(t.Month())), true
+	case "MM":
+		return pad2(int(t.Month())), true
+	case "MMM":
+		return l.ShortMonths[t.Month()-1], true
+	case "MMMM":
+		return l.Months[t.Month()-1], true
+	case "D":
+		return strconv.Itoa(t.Day()), true
+	case "DD":
+		return pad2(t.Day()), true
+	case "ddd":
+		return l.ShortWeekdays[t.Weekday()], true
+	case "dddd":
+		return l.Weekdays[t.Weekday()], true
+	}
+
+	f := fieldsOf(w, t)
+
+	switch token {
+	case "WW":
+		return pad2(f.week), true
+	case "WYYYY":
+		return strconv.Itoa(f.weekYear), true
+	case "FY":
+		return strconv.Itoa(f.fiscalYear), true
+	}
+
+	ordinalForm, feminine := false, false
+	if name, ok := strings.CutSuffix(token, "o"); ok {
+		ordinalForm, token = true, name
+	} else if name, ok := strings.CutSuffix(token, "a"); ok {
+		ordinalForm, feminine, token = true, true, name
+	}
+
+	var n int
+	switch token {
+	case "D":
+		n = t.Day()
+	case "Q":
+		n = f.quarter
+	case "S":
+		n = f.semester
+	case "H":
+		n = f.half
+	case "W":
+		n = f.weekOfMonth
+	case "P":
+		n = f.period
+	default:
+		return "", false
+	}
+
+	if ordinalForm {
+		return l.ordinal(n, feminine), true
+	}
+	return strconv.Itoa(n), true
+}
+
+// labelFields are the period numbers of the day t in the calendar of w.
+type labelFields struct {
+	quarter     int
+	semester    int
+	half        int
+	weekOfMonth int
+	week        int
+	weekYear    int
+	period      int
+	fiscalYear  int
+}
+
+func fieldsOf(w Window, t time.Time) labelFields {
+	f := labelFields{
+		quarter:     civilIndex(Quarter, t),
+		semester:    civilIndex(Semester, t),
+		half:        halfOfMonth(t),
+		weekOfMonth: weekIndex(t),
+		period:      indexOf(w, t),
+		fiscalYear:  t.Year(),
+	}
+	f.weekYear, f.week = t.ISOWeek()
+
+	switch v := w.(type) {
+	case *CalendarWeekWindow:
+		f.weekYear, f.week = calendarWeekOfYear(t, v.firstDay)
+	case *FiscalWindow:
+		f.quarter = v.calendar.index(FiscalQuarter, t)
+		f.semester = v.calendar.index(FiscalSemester, t)
+		f.fiscalYear = v.calendar.FiscalYear(t)
+	case *RetailWindow:
+		f.quarter = v.calendar.index(RetailQuarter, t)
+		f.semester = (v.calendar.month(t)-1)/6 + 1
+		f.fiscalYear = v.calendar.YearEnd.FiscalYear(t)
+	case *WeekYearWindow:
+		f.fiscalYear = v.rule.FiscalYear(t)
+	case *ThirteenPeriodWindow:
+		f.fiscalYear = v.calendar.YearEnd.FiscalYear(t)
+	case *BroadcastWindow:
+		f.weekYear, f.week = broadcastWeekOf(t)
+		f.quarter = broadcastIndex(BroadcastQuarter, t)
+		f.semester = (broadcastIndex(BroadcastMonth, t)-1)/6 + 1
+		f.fiscalYear = f.weekYear
+	}
+
+	return f
+}
+
+// indexOf returns the Index of the period of w containing t. On w's last
+// day that is w.Index() itself; other days use the helper of w's calendar
+// that Index uses.
+func indexOf(w Window, t time.Time) int {
+	if truncateToDay(t).Equal(truncateToDay(w.End())) {
+		return w.Index()
+	}
+
+	switch v := w.(type) {
+	case *YearWindow, *HalfYearWindow, *QuarterWindow, *MonthWindow, *HalfMonthWindow, *WeekWindow, *DayWindow:
+		return civilIndex(PeriodOf(w), t)
+	case *ISOWeekWindow:
+		_, week := t.ISOWeek()
+		return week
+	case *CalendarWeekWindow:
+		_, week := calendarWeekOfYear(t, v.firstDay)
+		return week
+	case *FiscalWindow:
+		return v.calendar.index(v.period, t)
+	case *RetailWindow:
+		return v.calendar.index(v.period, t)
+	case *ThirteenPeriodWindow:
+		return v.calendar.index(v.period, t)
+	case *BroadcastWindow:
+		return broadcastIndex(v.period, t)
+	default:
+		return 1
+	}
+}
+
+func halfOfMonth(t time.Time) int {
+	if t.Day() >= 16 {
+		return 2
+	}
+	return 1
+}
+
+func pad2(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,84 @@
+package timespan_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		w    timespan.Window
+		want string
+	}{
+		{"year", timespan.NewYearWindowStartingOn(mustDate(t, "2026-01-01")), "2026"},
+		{"semester", timespan.NewSemesterWindowEndingOn(mustDate(t, "2026-12-31")), "H2 2026"},
+		{"quarter", timespan.NewQuarterWindowEndingOn(mustDate(t, "2026-03-31")), "Q1 2026"},
+		{"month", timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-31")), "March 2026"},
+		{"half month", timespan.NewHalfMonthWindowStartingOn(mustDate(t, "2026-03-01")), "1st half of March 2026"},
+		{"week of month", timespan.NewWeekWindowStartingOn(mustDate(t, "2026-01-15")), "Week 3 of Jan 2026"},
+		{"iso week", timespan.NewISOWeekWindowEndingOn(mustDate(t, "2026-01-04")), "W01 2026"},
+		{"sunday week", timespan.NewCalendarWeekWindowEndingOn(mustDate(t, "2026-01-03"), time.Sunday), "W01 2026"},
+		{"fiscal quarter", timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-03-31")), "Q4 FY2026"},
+		{"fiscal month", timespan.NewFiscalMonthWindowEndingOn(fyApril, mustDate(t, "2025-04-30")), "P1 FY2026"},
+		{"fiscal year", timespan.NewFiscalYearWindowStartingOn(fyApril, mustDate(t, "2025-04-01")), "FY2026"},
+		{"broadcast month", timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-01-25")), "January 2026"},
+		{"accounting period", timespan.NewAccountingPeriodWindowStartingOn(thirteen, mustDate(t, "2026-01-25")), "P2 FY2026"},
+		{"partial quarter", timespan.NewQuarterWindowEndingOn(mustDate(t, "2026-02-15")), "Jan 1 – Feb 15, 2026"},
+		{"partial month", timespan.NewMonthWindowStartingOn(mustDate(t, "2026-01-10")), "Jan 10 – Jan 31, 2026"},
+		{"custom", timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-20")), "Jan 10 – Jan 20, 2026"},
+		{"custom across years", timespan.NewCustomWindow(mustDate(t, "2025-12-28"), mustDate(t, "2026-01-03")), "Dec 28, 2025 – Jan 3, 2026"},
+		{"single day", timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-10")), "Jan 10, 2026"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timespan.Label(tt.w); got != tt.want {
+				t.Errorf("Label = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	march := timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-31"))
+	fiscal := timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2025-06-30"))
+
+	tests := []struct {
+		name   string
+		w      timespan.Window
+		layout string
+		want   string
+	}{
+		{"dates", march, "{s.YYYY}-{s.MM}-{s.DD}/{e.YY}{e.M}{e.D}", "2026-03-01/26331"},
+		{"names", march, "{s.dddd} {s.Do} {MMM}, {s.ddd}", "Sunday 1st Mar, Sun"},
+		{"calendar quarter", march, "Q{Q} {Qo} S{S} {So}", "Q1 1st S1 1st"},
+		{"fiscal quarter", fiscal, "Q{Q} of FY{FY}, {Po} quarter", "Q1 of FY2026, 1st quarter"},
+		{"week tokens", march, "{W} {Wo} {H} {Ho} W{WW}-{WYYYY}", "4 4th 2 2nd W14-2026"},
+		{"escape and unknown", march, "{{Q} {X} {Q", "{Q} {X} {Q"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timespan.Format(tt.w, tt.layout); got != tt.want {
+				t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat_PeriodMatchesIndex(t *testing.T) {
+	for name, w := range encodingFixtures(t) {
+		t.Run(name, func(t *testing.T) {
+			want := strconv.Itoa(w.Index())
+			for _, layout := range []string{"{P}", "{s.P}", "{e.P}"} {
+				if got := timespan.Format(w, layout); got != want {
+					t.Errorf("Format(%q) = %q, want Index() %s", layout, got, want)
+				}
+			}
+		})
+	}
+}
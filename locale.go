@@ -0,0 +1,353 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnknownLocale    = errors.New("timespan: unknown locale")
+	ErrLocaleRegistered = errors.New("timespan: locale already registered")
+	ErrInvalidLocale    = errors.New("timespan: invalid locale")
+)
+
+// Locale holds the names and layouts used to label windows in a language.
+type Locale struct {
+	// Tag is a BCP 47 language tag such as "en" or "pt-BR".
+	Tag string
+
+	Months        [12]string
+	ShortMonths   [12]string
+	Weekdays      [7]string
+	ShortWeekdays [7]string
+
+	// Ordinal writes n as an ordinal. Feminine selects the feminine form in
+	// languages that have one, as in "1º trimestre" and "2ª quinzena". A nil
+	// Ordinal writes plain numbers.
+	Ordinal func(n int, feminine bool) string
+
+	// Periods names each period, as in "quarter" or "trimestre".
+	Periods map[Period]string
+
+	// Layouts are the Format layouts Label uses for complete windows.
+	Layouts map[Period]string
+
+	// DateLayout and ShortDateLayout write one day of a range label, with
+	// and without its year. RangeSeparator goes between the two days.
+	DateLayout      string
+	ShortDateLayout string
+	RangeSeparator  string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{}
+)
+
+func init() {
+	for _, l := range []Locale{english, brazilianPortuguese, spanish, german, french} {
+		locales[strings.ToLower(l.Tag)] = l
+	}
+}
+
+// RegisterLocale makes l available to LookupLocale. Registered locales
+// cannot be replaced.
+func RegisterLocale(l Locale) error {
+	if l.Tag == "" || slices.Contains(l.Months[:], "") {
+		return fmt.Errorf("%w: %q", ErrInvalidLocale, l.Tag)
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	key := strings.ToLower(l.Tag)
+	if _, ok := locales[key]; ok {
+		return fmt.Errorf("%w: %q", ErrLocaleRegistered, l.Tag)
+	}
+
+	l.Periods = maps.Clone(l.Periods)
+	l.Layouts = maps.Clone(l.Layouts)
+	locales[key] = l
+	return nil
+}
+
+// LookupLocale returns the locale registered for tag. When there is no exact
+// match a locale of the same language is used, so "pt" and "pt-PT" find
+// "pt-BR" and "es-MX" finds "es". The bundled locales are en, pt-BR, es, de
+// and fr.
+func LookupLocale(tag string) (Locale, error) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	key := strings.ToLower(tag)
+	l, ok := locales[key]
+	if !ok {
+		lang, _, _ := strings.Cut(key, "-")
+		if l, ok = locales[lang]; !ok {
+			tags := slices.Sorted(maps.Keys(locales))
+			for _, t := range tags {
+				if strings.HasPrefix(t, lang+"-") {
+					l, ok = locales[t], true
+					break
+				}
+			}
+		}
+	}
+	if !ok {
+		return Locale{}, fmt.Errorf("%w: %q", ErrUnknownLocale, tag)
+	}
+
+	l.Periods = maps.Clone(l.Periods)
+	l.Layouts = maps.Clone(l.Layouts)
+	return l, nil
+}
+
+// Locales returns the registered locale tags in order.
+func Locales() []string {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	tags := make([]string, 0, len(locales))
+	for _, l := range locales {
+		tags = append(tags, l.Tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// PeriodName returns the name of p in l, or p itself when l does not name it.
+func (l Locale) PeriodName(p Period) string {
+	if name, ok := l.Periods[p]; ok {
+		return name
+	}
+	return string(p)
+}
+
+func (l Locale) ordinal(n int, feminine bool) string {
+	if l.Ordinal == nil {
+		return strconv.Itoa(n)
+	}
+	return l.Ordinal(n, feminine)
+}
+
+// calendarLayouts are the label layouts of one locale, expanded by
+// layoutsOf into a layout per period.
+type calendarLayouts struct {
+	year, semester, quarter, month, halfMonth, weekOfMonth, week string
+	fiscalPeriod, fiscalQuarter, fiscalSemester, fiscalYear      string
+}
+
+func layoutsOf(c calendarLayouts) map[Period]string {
+	return map[Period]string{
+		Year:             c.year,
+		Semester:         c.semester,
+		Quarter:          c.quarter,
+		Month:            c.month,
+		HalfMonth:        c.halfMonth,
+		Week:             c.weekOfMonth,
+		ISOWeek:          c.week,
+		SundayWeek:       c.week,
+		MondayWeek:       c.week,
+		SaturdayWeek:     c.week,
+		CalendarWeek:     c.week,
+		FiscalMonth:      c.fiscalPeriod,
+		FiscalQuarter:    c.fiscalQuarter,
+		FiscalSemester:   c.fiscalSemester,
+		FiscalYear:       c.fiscalYear,
+		RetailMonth:      c.fiscalPeriod,
+		RetailQuarter:    c.fiscalQuarter,
+		RetailYear:       c.fiscalYear,
+		WeekYear:         c.fiscalYear,
+		AccountingPeriod: c.fiscalPeriod,
+		AccountingYear:   c.fiscalYear,
+		BroadcastWeek:    c.week,
+		BroadcastMonth:   strings.ReplaceAll(c.month, "{YYYY}", "{FY}"),
+		BroadcastQuarter: strings.ReplaceAll(c.quarter, "{YYYY}", "{FY}"),
+		BroadcastYear:    "{FY}",
+	}
+}
+
+var english = Locale{
+	Tag:           "en",
+	Months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	ShortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	Weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	ShortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	Ordinal: func(n int, _ bool) string {
+		suffix := "th"
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+		if n%100 >= 11 && n%100 <= 13 {
+			suffix = "th"
+		}
+		return strconv.Itoa(n) + suffix
+	},
+	Periods: map[Period]string{
+		Week: "week", ISOWeek: "week", HalfMonth: "half month", Month: "month",
+		Quarter: "quarter", Semester: "semester", Year: "year",
+	},
+	Layouts: layoutsOf(calendarLayouts{
+		year:           "{YYYY}",
+		semester:       "H{S} {YYYY}",
+		quarter:        "Q{Q} {YYYY}",
+		month:          "{MMMM} {YYYY}",
+		halfMonth:      "{Ho} half of {MMMM} {YYYY}",
+		weekOfMonth:    "Week {W} of {MMM} {YYYY}",
+		week:           "W{WW} {WYYYY}",
+		fiscalPeriod:   "P{P} FY{FY}",
+		fiscalQuarter:  "Q{Q} FY{FY}",
+		fiscalSemester: "H{S} FY{FY}",
+		fiscalYear:     "FY{FY}",
+	}),
+	DateLayout:      "{MMM} {D}, {YYYY}",
+	ShortDateLayout: "{MMM} {D}",
+	RangeSeparator:  " – ",
+}
+
+var brazilianPortuguese = Locale{
+	Tag:           "pt-BR",
+	Months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	ShortMonths:   [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+	Weekdays:      [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	ShortWeekdays: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+	Ordinal: func(n int, feminine bool) string {
+		if feminine {
+			return strconv.Itoa(n) + "ª"
+		}
+		return strconv.Itoa(n) + "º"
+	},
+	Periods: map[Period]string{
+		Week: "semana", ISOWeek: "semana", HalfMonth: "quinzena", Month: "mês",
+		Quarter: "trimestre", Semester: "semestre", Year: "ano",
+	},
+	Layouts: layoutsOf(calendarLayouts{
+		year:           "{YYYY}",
+		semester:       "{So} semestre de {YYYY}",
+		quarter:        "{Qo} trimestre de {YYYY}",
+		month:          "{MMMM} de {YYYY}",
+		halfMonth:      "{Ha} quinzena de {MMMM} de {YYYY}",
+		weekOfMonth:    "{Wa} semana de {MMMM} de {YYYY}",
+		week:           "S{WW} {WYYYY}",
+		fiscalPeriod:   "P{P} AF{FY}",
+		fiscalQuarter:  "{Qo} trimestre AF{FY}",
+		fiscalSemester: "{So} semestre AF{FY}",
+		fiscalYear:     "AF{FY}",
+	}),
+	DateLayout:      "{D} de {MMM} de {YYYY}",
+	ShortDateLayout: "{D} de {MMM}",
+	RangeSeparator:  " – ",
+}
+
+var spanish = Locale{
+	Tag:           "es",
+	Months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	ShortMonths:   [12]string{"ene.", "feb.", "mar.", "abr.", "may.", "jun.", "jul.", "ago.", "sept.", "oct.", "nov.", "dic."},
+	Weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	ShortWeekdays: [7]string{"dom.", "lun.", "mar.", "mié.", "jue.", "vie.", "sáb."},
+	Ordinal: func(n int, feminine bool) string {
+		if feminine {
+			return strconv.Itoa(n) + ".ª"
+		}
+		return strconv.Itoa(n) + ".º"
+	},
+	Periods: map[Period]string{
+		Week: "semana", ISOWeek: "semana", HalfMonth: "quincena", Month: "mes",
+		Quarter: "trimestre", Semester: "semestre", Year: "año",
+	},
+	Layouts: layoutsOf(calendarLayouts{
+		year:           "{YYYY}",
+		semester:       "{So} semestre de {YYYY}",
+		quarter:        "{Qo} trimestre de {YYYY}",
+		month:          "{MMMM} de {YYYY}",
+		halfMonth:      "{Ha} quincena de {MMMM} de {YYYY}",
+		weekOfMonth:    "{Wa} semana de {MMMM} de {YYYY}",
+		week:           "S{WW} {WYYYY}",
+		fiscalPeriod:   "P{P} AF{FY}",
+		fiscalQuarter:  "{Qo} trimestre AF{FY}",
+		fiscalSemester: "{So} semestre AF{FY}",
+		fiscalYear:     "AF{FY}",
+	}),
+	DateLayout:      "{D} de {MMM} de {YYYY}",
+	ShortDateLayout: "{D} de {MMM}",
+	RangeSeparator:  " – ",
+}
+
+var german = Locale{
+	Tag:           "de",
+	Months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	ShortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+	Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	ShortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	Ordinal: func(n int, _ bool) string {
+		return strconv.Itoa(n) + "."
+	},
+	Periods: map[Period]string{
+		Week: "Woche", ISOWeek: "Kalenderwoche", HalfMonth: "Monatshälfte", Month: "Monat",
+		Quarter: "Quartal", Semester: "Halbjahr", Year: "Jahr",
+	},
+	Layouts: layoutsOf(calendarLayouts{
+		year:           "{YYYY}",
+		semester:       "{So} Halbjahr {YYYY}",
+		quarter:        "{Qo} Quartal {YYYY}",
+		month:          "{MMMM} {YYYY}",
+		halfMonth:      "{Ho} Hälfte {MMMM} {YYYY}",
+		weekOfMonth:    "{Wo} Woche {MMMM} {YYYY}",
+		week:           "KW {WW} {WYYYY}",
+		fiscalPeriod:   "P{P} GJ {FY}",
+		fiscalQuarter:  "{Qo} Quartal GJ {FY}",
+		fiscalSemester: "{So} Halbjahr GJ {FY}",
+		fiscalYear:     "GJ {FY}",
+	}),
+	DateLayout:      "{D}. {MMM} {YYYY}",
+	ShortDateLayout: "{D}. {MMM}",
+	RangeSeparator:  " – ",
+}
+
+var french = Locale{
+	Tag:           "fr",
+	Months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	ShortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	Ordinal: func(n int, feminine bool) string {
+		switch {
+		case n == 1 && feminine:
+			return "1re"
+		case n == 1:
+			return "1er"
+		default:
+			return strconv.Itoa(n) + "e"
+		}
+	},
+	Periods: map[Period]string{
+		Week: "semaine", ISOWeek: "semaine", HalfMonth: "quinzaine", Month: "mois",
+		Quarter: "trimestre", Semester: "semestre", Year: "année",
+	},
+	Layouts: layoutsOf(calendarLayouts{
+		year:           "{YYYY}",
+		semester:       "{So} semestre {YYYY}",
+		quarter:        "{Qo} trimestre {YYYY}",
+		month:          "{MMMM} {YYYY}",
+		halfMonth:      "{Ha} quinzaine de {MMMM} {YYYY}",
+		weekOfMonth:    "{Wa} semaine de {MMMM} {YYYY}",
+		week:           "S{WW} {WYYYY}",
+		fiscalPeriod:   "P{P} exercice {FY}",
+		fiscalQuarter:  "{Qo} trimestre exercice {FY}",
+		fiscalSemester: "{So} semestre exercice {FY}",
+		fiscalYear:     "exercice {FY}",
+	}),
+	DateLayout:      "{D} {MMM} {YYYY}",
+	ShortDateLayout: "{D} {MMM}",
+	RangeSeparator:  " – ",
+}
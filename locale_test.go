@@ -0,0 +1,133 @@
+package timespan_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func mustLocale(t *testing.T, tag string) timespan.Locale {
+	t.Helper()
+
+	l, err := timespan.LookupLocale(tag)
+	if err != nil {
+		t.Fatalf("LookupLocale(%q): %v", tag, err)
+	}
+	return l
+}
+
+func TestLocale_Label(t *testing.T) {
+	quarter := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-01-01"))
+	semester := timespan.NewSemesterWindowEndingOn(mustDate(t, "2026-06-30"))
+	month := timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-31"))
+	half := timespan.NewHalfMonthWindowEndingOn(mustDate(t, "2026-03-31"))
+	partial := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-03-10"))
+	across := timespan.NewCustomWindow(mustDate(t, "2025-12-20"), mustDate(t, "2026-01-05"))
+
+	tests := []struct {
+		tag  string
+		w    timespan.Window
+		want string
+	}{
+		{"pt-BR", quarter, "1º trimestre de 2026"},
+		{"pt-BR", semester, "1º semestre de 2026"},
+		{"pt-BR", month, "março de 2026"},
+		{"pt-BR", half, "2ª quinzena de março de 2026"},
+		{"pt-BR", partial, "10 de mar. – 31 de mar. de 2026"},
+		{"pt-BR", across, "20 de dez. de 2025 – 5 de jan. de 2026"},
+		{"es", quarter, "1.º trimestre de 2026"},
+		{"es", half, "2.ª quincena de marzo de 2026"},
+		{"de", quarter, "1. Quartal 2026"},
+		{"de", partial, "10. März – 31. März 2026"},
+		{"fr", quarter, "1er trimestre 2026"},
+		{"fr", timespan.NewHalfMonthWindowStartingOn(mustDate(t, "2026-03-01")), "1re quinzaine de mars 2026"},
+		{"en", half, "2nd half of March 2026"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag+"/"+tt.want, func(t *testing.T) {
+			if got := mustLocale(t, tt.tag).Label(tt.w); got != tt.want {
+				t.Errorf("Label = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocale_Format(t *testing.T) {
+	w := timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-31"))
+
+	got := mustLocale(t, "pt-BR").Format(w, "{s.dddd}, {s.Do} de {MMMM} ({s.ddd}) – {Ha} quinzena")
+	want := "domingo, 1º de março (dom.) – 2ª quinzena"
+	if got != want {
+		t.Errorf("Format = %q, want %q", got, want)
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"pt-BR", "pt-BR"},
+		{"pt-br", "pt-BR"},
+		{"pt", "pt-BR"},
+		{"pt-PT", "pt-BR"},
+		{"es-MX", "es"},
+		{"en-US", "en"},
+	}
+
+	for _, tt := range tests {
+		if got := mustLocale(t, tt.tag).Tag; got != tt.want {
+			t.Errorf("LookupLocale(%q).Tag = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+
+	if _, err := timespan.LookupLocale("ja"); !errors.Is(err, timespan.ErrUnknownLocale) {
+		t.Errorf("LookupLocale(ja) err = %v, want ErrUnknownLocale", err)
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	it := mustLocale(t, "es")
+	it.Tag = "it-test"
+	it.Months = [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"}
+	it.Ordinal = func(n int, feminine bool) string {
+		if feminine {
+			return strconv.Itoa(n) + "ª"
+		}
+		return strconv.Itoa(n) + "º"
+	}
+	it.Layouts[timespan.Quarter] = "{Qo} trimestre {YYYY}"
+
+	if err := timespan.RegisterLocale(it); err != nil {
+		t.Fatalf("RegisterLocale: %v", err)
+	}
+
+	w := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-04-01"))
+	if got := mustLocale(t, "it-test").Label(w); got != "2º trimestre 2026" {
+		t.Errorf("Label = %q, want %q", got, "2º trimestre 2026")
+	}
+	if got := mustLocale(t, "es").Label(w); got != "2.º trimestre de 2026" {
+		t.Errorf("bundled locale changed: Label = %q", got)
+	}
+
+	if err := timespan.RegisterLocale(it); !errors.Is(err, timespan.ErrLocaleRegistered) {
+		t.Errorf("second RegisterLocale err = %v, want ErrLocaleRegistered", err)
+	}
+	if err := timespan.RegisterLocale(timespan.Locale{Tag: "xx"}); !errors.Is(err, timespan.ErrInvalidLocale) {
+		t.Errorf("RegisterLocale without months err = %v, want ErrInvalidLocale", err)
+	}
+}
+
+func TestLocale_PeriodName(t *testing.T) {
+	pt := mustLocale(t, "pt-BR")
+
+	if got := pt.PeriodName(timespan.HalfMonth); got != "quinzena" {
+		t.Errorf("PeriodName(HalfMonth) = %q, want quinzena", got)
+	}
+	if got := pt.PeriodName(timespan.Custom); got != "custom" {
+		t.Errorf("PeriodName(Custom) = %q, want custom", got)
+	}
+}
@@ -10,7 +10,13 @@ type MonthWindow struct {
 }
 
 func (m *MonthWindow) Index() int {
-	return int(m.end.Month())
+	return civilIndex(Month, m.end)
+}
+
+func (m *MonthWindow) Period() Period { return Month }
+
+func (m *MonthWindow) Validate() error {
+	return validateSpan(Month, m.anchor, m.start, m.end, spanOf(monthStart, monthEnd))
 }
 
 func (m *MonthWindow) Start() time.Time { return m.start }
@@ -29,6 +35,18 @@ func (m *MonthWindow) SetEnd(t time.Time) {
 	}
 }
 
+func (m *MonthWindow) WithStart(t time.Time) Window {
+	c := *m
+	c.SetStart(t)
+	return &c
+}
+
+func (m *MonthWindow) WithEnd(t time.Time) Window {
+	c := *m
+	c.SetEnd(t)
+	return &c
+}
+
 func (m *MonthWindow) Next(s ...Step) Window {
 	if step, ok := GetFirst(s); ok && step == StepYear {
 		return m.shift(12)
@@ -108,6 +126,16 @@ func NewMonthWindowEndingOn(t time.Time) Window {
 	}
 }
 
+func monthStart(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
+
+func monthEnd(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, t.Location())
+}
+
 func shiftMonthClamp(t time.Time, delta int) time.Time {
 	y, m, d := t.Date()
 	loc := t.Location()
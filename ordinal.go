@@ -0,0 +1,204 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrIndexOutOfRange = errors.New("timespan: index out of range")
+
+// ordinalEpochYear is the year whose first period has ordinal 0.
+const ordinalEpochYear = 1970
+
+// periodsPerYear are the periods WindowAt numbers within a calendar or
+// broadcast year, with the number of periods in a year.
+var periodsPerYear = map[Period]int{
+	Year:             1,
+	Semester:         2,
+	Quarter:          4,
+	Month:            12,
+	HalfMonth:        24,
+	Week:             48,
+	BroadcastYear:    1,
+	BroadcastQuarter: 4,
+	BroadcastMonth:   12,
+}
+
+// civilIndex returns the 1-based position of the period p containing t
+// within its calendar year, for the periods of the calendar year.
+func civilIndex(p Period, t time.Time) int {
+	m := int(t.Month())
+
+	switch p {
+	case Semester:
+		return (m-1)/6 + 1
+	case Quarter:
+		return (m-1)/3 + 1
+	case Month:
+		return m
+	case HalfMonth:
+		return 2*(m-1) + halfOfMonth(t)
+	case Week:
+		return 4*(m-1) + weekIndex(t)
+	case Day:
+		return t.YearDay()
+	default:
+		return 1
+	}
+}
+
+var retailPerYear = map[Period]int{RetailMonth: 12, RetailQuarter: 4, RetailYear: 1}
+
+// weekPeriods are the seven-day periods, by the weekday they start on.
+var weekPeriods = map[Period]time.Weekday{
+	ISOWeek:       time.Monday,
+	MondayWeek:    time.Monday,
+	SundayWeek:    time.Sunday,
+	SaturdayWeek:  time.Saturday,
+	BroadcastWeek: time.Monday,
+}
+
+// Ordinal returns the number of periods between the period containing the
+// end of w and the one containing January 1, 1970, which is 0. Ordinals of
+// windows of the same period sort them and count the periods between them:
+// for months, Ordinal(b) - Ordinal(a) is the number of months from a to b.
+//
+// Fiscal, retail, thirteen-period and week-based years count from their
+// year 1970. Custom windows, and window types this package does not know,
+// count days to their last day.
+func Ordinal(w Window) int {
+	end := w.End()
+	epoch := time.Date(ordinalEpochYear, 1, 1, 0, 0, 0, 0, end.Location())
+
+	yearly := func(year, perYear int) int {
+		return (year-ordinalEpochYear)*perYear + w.Index() - 1
+	}
+	weekly := func(firstDay time.Weekday) int {
+		return daysBetween(calendarWeekStart(epoch, firstDay), calendarWeekStart(end, firstDay)) / 7
+	}
+
+	switch v := w.(type) {
+	case *YearWindow, *HalfYearWindow, *QuarterWindow, *MonthWindow, *HalfMonthWindow, *WeekWindow:
+		return yearly(end.Year(), periodsPerYear[PeriodOf(w)])
+	case *ISOWeekWindow:
+		return weekly(time.Monday)
+	case *CalendarWeekWindow:
+		return weekly(v.firstDay)
+	case *BroadcastWindow:
+		if v.period == BroadcastWeek {
+			return weekly(time.Monday)
+		}
+		return yearly(v.BroadcastYear(), periodsPerYear[v.period])
+	case *FiscalWindow:
+		return yearly(v.FiscalYear(), 12/fiscalMonths(v.period))
+	case *RetailWindow:
+		return yearly(v.RetailYear(), retailPerYear[v.period])
+	case *ThirteenPeriodWindow:
+		if v.period == AccountingPeriod {
+			return yearly(v.FiscalYear(), 13)
+		}
+		return yearly(v.FiscalYear(), 1)
+	case *WeekYearWindow:
+		return yearly(v.FiscalYear(), 1)
+	default:
+		return daysBetween(epoch, end)
+	}
+}
+
+// WindowAt returns the complete, start-anchored window of period p with the
+// given 1-based index within year, the inverse of Index. Dates are built in
+// UTC. Weeks are numbered in their own week-based year and broadcast periods
+// in the broadcast year.
+func WindowAt(p Period, year, index int) (Window, error) {
+	return WindowAtInLocation(p, year, index, time.UTC)
+}
+
+// WindowAtInLocation is like WindowAt but builds the window in loc.
+func WindowAtInLocation(p Period, year, index int, loc *time.Location) (Window, error) {
+	fail := func(n int) (Window, error) {
+		return nil, fmt.Errorf("%w: %s %d of %d has %d", ErrIndexOutOfRange, p, index, year, n)
+	}
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, loc)
+
+	if n, ok := periodsPerYear[p]; ok && (index < 1 || index > n) {
+		return fail(n)
+	}
+
+	switch p {
+	case Year:
+		return NewYearWindowStartingOn(jan1), nil
+	case Semester:
+		return NewSemesterWindowStartingOn(jan1.AddDate(0, 6*(index-1), 0)), nil
+	case Quarter:
+		return NewQuarterWindowStartingOn(jan1.AddDate(0, 3*(index-1), 0)), nil
+	case Month:
+		return NewMonthWindowStartingOn(jan1.AddDate(0, index-1, 0)), nil
+	case HalfMonth:
+		return NewHalfMonthWindowStartingOn(jan1.AddDate(0, (index-1)/2, 15*((index-1)%2))), nil
+	case Week:
+		return NewWeekWindowStartingOn(jan1.AddDate(0, (index-1)/4, 7*((index-1)%4))), nil
+	case Day:
+		if n := jan1.AddDate(1, 0, -1).YearDay(); index < 1 || index > n {
+			return fail(n)
+		}
+		return NewDayWindowStartingOn(jan1.AddDate(0, 0, index-1)), nil
+	case ISOWeek:
+		if n := isoWeeksInYear(year); index < 1 || index > n {
+			return fail(n)
+		}
+		return NewISOWeekWindowStartingOn(isoWeekDate(year, index, loc)), nil
+	case SundayWeek, MondayWeek, SaturdayWeek:
+		firstDay := weekPeriods[p]
+		if n := calendarWeeksInYear(year, firstDay); index < 1 || index > n {
+			return fail(n)
+		}
+		return NewCalendarWeekWindowStartingOn(calendarWeekStart(jan1, firstDay).AddDate(0, 0, 7*(index-1)), firstDay), nil
+	case BroadcastYear:
+		start, _ := broadcastMonthBounds(year, time.January, loc)
+		return NewBroadcastYearWindowStartingOn(start), nil
+	case BroadcastQuarter:
+		start, _ := broadcastMonthBounds(year, time.Month(3*index-2), loc)
+		return NewBroadcastQuarterWindowStartingOn(start), nil
+	case BroadcastMonth:
+		start, _ := broadcastMonthBounds(year, time.Month(index), loc)
+		return NewBroadcastMonthWindowStartingOn(start), nil
+	case BroadcastWeek:
+		start, _ := broadcastMonthBounds(year, time.January, loc)
+		_, end := broadcastMonthBounds(year, time.December, loc)
+		if n := (daysBetween(start, end) + 1) / 7; index < 1 || index > n {
+			return fail(n)
+		}
+		return NewBroadcastWeekWindowStartingOn(start.AddDate(0, 0, 7*(index-1))), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPeriod, p)
+	}
+}
+
+// WindowAtOrdinal returns the complete, start-anchored window of period p
+// with ordinal n in loc, the inverse of Ordinal for the periods WindowAt
+// supports.
+func WindowAtOrdinal(p Period, n int, loc *time.Location) (Window, error) {
+	epoch := time.Date(ordinalEpochYear, 1, 1, 0, 0, 0, 0, loc)
+
+	if perYear, ok := periodsPerYear[p]; ok {
+		offset := n % perYear
+		if offset < 0 {
+			offset += perYear
+		}
+		return WindowAtInLocation(p, ordinalEpochYear+(n-offset)/perYear, offset+1, loc)
+	}
+
+	c, err := lookupPeriod(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if firstDay, ok := weekPeriods[p]; ok {
+		return c.startingOn(calendarWeekStart(epoch, firstDay).AddDate(0, 0, 7*n)), nil
+	}
+	if p == Day {
+		return c.startingOn(epoch.AddDate(0, 0, n)), nil
+	}
+	return nil, fmt.Errorf("%w: no ordinals for %q", ErrUnknownPeriod, p)
+}
@@ -0,0 +1,129 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestWindowAt(t *testing.T) {
+	tests := []struct {
+		period timespan.Period
+		year   int
+		index  int
+		want   span
+	}{
+		{timespan.Year, 2026, 1, span{"2026-01-01", "2026-12-31"}},
+		{timespan.Semester, 2026, 2, span{"2026-07-01", "2026-12-31"}},
+		{timespan.Quarter, 2026, 3, span{"2026-07-01", "2026-09-30"}},
+		{timespan.Month, 2026, 2, span{"2026-02-01", "2026-02-28"}},
+		{timespan.HalfMonth, 2026, 4, span{"2026-02-16", "2026-02-28"}},
+		{timespan.Week, 2026, 8, span{"2026-02-22", "2026-02-28"}},
+		{timespan.Day, 2024, 366, span{"2024-12-31", "2024-12-31"}},
+		{timespan.ISOWeek, 2026, 53, span{"2026-12-28", "2027-01-03"}},
+		{timespan.SundayWeek, 2026, 1, span{"2025-12-28", "2026-01-03"}},
+		{timespan.BroadcastYear, 2026, 1, span{"2025-12-29", "2026-12-27"}},
+		{timespan.BroadcastMonth, 2026, 3, span{"2026-02-23", "2026-03-29"}},
+		{timespan.BroadcastWeek, 2026, 1, span{"2025-12-29", "2026-01-04"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.period), func(t *testing.T) {
+			got, err := timespan.WindowAt(tt.period, tt.year, tt.index)
+			if err != nil {
+				t.Fatalf("WindowAt() error = %v", err)
+			}
+			assertWindow(t, got, mustDate(t, tt.want.start), mustDate(t, tt.want.end))
+
+			if idx := got.Index(); idx != tt.index {
+				t.Errorf("Index() = %d, want %d", idx, tt.index)
+			}
+
+			back, err := timespan.WindowAtOrdinal(tt.period, timespan.Ordinal(got), time.UTC)
+			if err != nil {
+				t.Fatalf("WindowAtOrdinal() error = %v", err)
+			}
+			assertWindow(t, back, got.Start(), got.End())
+		})
+	}
+}
+
+func TestWindowAt_Errors(t *testing.T) {
+	tests := []struct {
+		period  timespan.Period
+		year    int
+		index   int
+		wantErr error
+	}{
+		{timespan.Quarter, 2026, 0, timespan.ErrIndexOutOfRange},
+		{timespan.Quarter, 2026, 5, timespan.ErrIndexOutOfRange},
+		{timespan.Day, 2026, 366, timespan.ErrIndexOutOfRange},
+		{timespan.ISOWeek, 2025, 53, timespan.ErrIndexOutOfRange},
+		{timespan.Year, 2026, 2, timespan.ErrIndexOutOfRange},
+		{timespan.Custom, 2026, 1, timespan.ErrUnknownPeriod},
+		{"decade", 2026, 1, timespan.ErrUnknownPeriod},
+	}
+
+	for _, tt := range tests {
+		if _, err := timespan.WindowAt(tt.period, tt.year, tt.index); !errors.Is(err, tt.wantErr) {
+			t.Errorf("WindowAt(%s, %d, %d) error = %v, want %v", tt.period, tt.year, tt.index, err, tt.wantErr)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		name   string
+		window timespan.Window
+		want   int
+	}{
+		{"epoch month", timespan.NewMonthWindowStartingOn(mustDate(t, "1970-01-01")), 0},
+		{"month", timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-12")), 56*12 + 2},
+		{"month before the epoch", timespan.NewMonthWindowStartingOn(mustDate(t, "1969-12-01")), -1},
+		{"quarter", timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-07-01")), 56*4 + 2},
+		{"day", timespan.NewDayWindowStartingOn(mustDate(t, "1970-01-11")), 10},
+		{"ISO week of the epoch", timespan.NewISOWeekWindowStartingOn(mustDate(t, "1969-12-29")), 0},
+		{"ISO week", timespan.NewISOWeekWindowStartingOn(mustDate(t, "1970-01-05")), 1},
+		{"day far after the epoch", timespan.NewDayWindowStartingOn(mustDate(t, "2500-01-01")), 193579},
+		{"day far before the epoch", timespan.NewDayWindowStartingOn(mustDate(t, "0001-01-01")), -719162},
+		{"ISO week far after the epoch", timespan.NewISOWeekWindowStartingOn(mustDate(t, "2500-01-04")), 27655},
+		{"custom", timespan.NewCustomWindow(mustDate(t, "1970-01-01"), mustDate(t, "1970-02-01")), 31},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := timespan.Ordinal(tt.window); got != tt.want {
+				t.Errorf("Ordinal() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrdinal_FiscalYear(t *testing.T) {
+	q1 := timespan.NewFiscalQuarterWindowStartingOn(fyApril, mustDate(t, "2026-04-01"))
+	q2 := q1.Next()
+	nextQ1 := q1.Next(timespan.StepYear)
+
+	if got := timespan.Ordinal(q2) - timespan.Ordinal(q1); got != 1 {
+		t.Errorf("ordinal distance to the next fiscal quarter = %d, want 1", got)
+	}
+	if got := timespan.Ordinal(nextQ1) - timespan.Ordinal(q1); got != 4 {
+		t.Errorf("ordinal distance over a fiscal year = %d, want 4", got)
+	}
+}
+
+func TestWindowAtOrdinal_Arithmetic(t *testing.T) {
+	jan := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	got, err := timespan.WindowAtOrdinal(timespan.Month, timespan.Ordinal(jan)-13, time.UTC)
+	if err != nil {
+		t.Fatalf("WindowAtOrdinal() error = %v", err)
+	}
+	assertWindow(t, got, mustDate(t, "2024-12-01"), mustDate(t, "2024-12-31"))
+
+	if _, err := timespan.WindowAtOrdinal(timespan.Custom, 0, time.UTC); !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("WindowAtOrdinal(custom) error = %v, want ErrUnknownPeriod", err)
+	}
+}
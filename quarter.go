@@ -10,9 +10,13 @@ type QuarterWindow struct {
 }
 
 func (q *QuarterWindow) Index() int {
-	_, m, _ := q.end.Date()
+	return civilIndex(Quarter, q.end)
+}
+
+func (q *QuarterWindow) Period() Period { return Quarter }
 
-	return int(m / 3)
+func (q *QuarterWindow) Validate() error {
+	return validateSpan(Quarter, q.anchor, q.start, q.end, spanOf(quarterStart, quarterEnd))
 }
 
 func (q *QuarterWindow) Start() time.Time { return q.start }
@@ -31,6 +35,18 @@ func (q *QuarterWindow) SetEnd(t time.Time) {
 	q.end = t
 }
 
+func (q *QuarterWindow) WithStart(t time.Time) Window {
+	c := *q
+	c.SetStart(t)
+	return &c
+}
+
+func (q *QuarterWindow) WithEnd(t time.Time) Window {
+	c := *q
+	c.SetEnd(t)
+	return &c
+}
+
 func (q *QuarterWindow) Next(s ...Step) Window {
 	if step, ok := GetFirst(s); ok && step == StepYear {
 		return q.shift(12)
@@ -0,0 +1,151 @@
+package timespan
+
+import (
+	"iter"
+	"time"
+)
+
+type rangeConfig struct {
+	dropPartial bool
+}
+
+// RangeOption configures Range and its variants.
+type RangeOption func(*rangeConfig)
+
+// RangeDropPartial leaves out the window at the bound that the bound cuts
+// short, such as the current month when ranging up to today.
+func RangeDropPartial() RangeOption {
+	return func(c *rangeConfig) { c.dropPartial = true }
+}
+
+func rangeConfigOf(opts []RangeOption) rangeConfig {
+	var cfg rangeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// Range yields the consecutive windows of period p from the day of from to
+// the day of to, such as every month from January 2024 to today. The first
+// window starts on from, as the StartingOn constructor builds it, and the
+// window containing to ends on it, as the EndingOn constructor builds it,
+// unless RangeDropPartial is given and to is not the last day of its period.
+func Range(p Period, from, to time.Time, opts ...RangeOption) (iter.Seq[Window], error) {
+	c, first, last, err := rangeBounds(p, from, to)
+	if err != nil {
+		return nil, err
+	}
+	cfg := rangeConfigOf(opts)
+
+	return func(yield func(Window) bool) {
+		for day := first; !day.After(last); {
+			start, end := c.endingOn(day).Start(), c.startingOn(day).End()
+			if end.After(last) && cfg.dropPartial {
+				return
+			}
+			if !yield(splitPiece(c, splitConfig{}, start, end, first, last)) {
+				return
+			}
+			day = end.AddDate(0, 0, 1)
+		}
+	}, nil
+}
+
+// RangeReverse yields the windows of Range from the latest to the earliest.
+func RangeReverse(p Period, from, to time.Time, opts ...RangeOption) (iter.Seq[Window], error) {
+	c, first, last, err := rangeBounds(p, from, to)
+	if err != nil {
+		return nil, err
+	}
+	cfg := rangeConfigOf(opts)
+
+	return func(yield func(Window) bool) {
+		for day := last; !day.Before(first); {
+			start, end := c.endingOn(day).Start(), c.startingOn(day).End()
+			if end.After(last) && cfg.dropPartial {
+				day = start.AddDate(0, 0, -1)
+				continue
+			}
+			if !yield(splitPiece(c, splitConfig{}, start, end, first, last)) {
+				return
+			}
+			day = start.AddDate(0, 0, -1)
+		}
+	}, nil
+}
+
+func rangeBounds(p Period, from, to time.Time) (periodConstructors, time.Time, time.Time, error) {
+	c, err := lookupPeriod(p)
+	if err != nil {
+		return periodConstructors{}, time.Time{}, time.Time{}, err
+	}
+
+	first, last := truncateToDay(from), truncateToDay(to)
+	if last.Before(first) {
+		return periodConstructors{}, time.Time{}, time.Time{}, &ValidationError{Period: p, Start: from, End: to, Err: ErrEndBeforeStart}
+	}
+	return c, first, last, nil
+}
+
+// RangeFrom yields w and the windows that follow it with Next while they
+// start on or before to, so the stepping rules of w's own type apply. The
+// last window may end after to; RangeDropPartial leaves it out.
+//
+// When Next returns a window that does not start after the previous one
+// ends, as it does for a partial week late in the month, the range
+// continues with the window of w's period that starts the next day. Custom
+// windows and windows of unregistered periods end the range there instead.
+func RangeFrom(w Window, to time.Time, opts ...RangeOption) iter.Seq[Window] {
+	cfg := rangeConfigOf(opts)
+
+	return func(yield func(Window) bool) {
+		for cur := w; !cur.Start().After(to); {
+			if cur.End().After(to) && cfg.dropPartial {
+				return
+			}
+			if !yield(cur) {
+				return
+			}
+
+			next := cur.Next()
+			if !next.Start().After(cur.End()) {
+				c, err := lookupPeriod(PeriodOf(cur))
+				if err != nil {
+					return
+				}
+				next = c.startingOn(truncateToDay(cur.End()).AddDate(0, 0, 1))
+			}
+			cur = next
+		}
+	}
+}
+
+// RangeBackFrom yields w and the windows that precede it with Prev while
+// they end on or after from. The last window may start before from;
+// RangeDropPartial leaves it out. A Prev that does not end before the
+// previous window starts is handled as in RangeFrom.
+func RangeBackFrom(w Window, from time.Time, opts ...RangeOption) iter.Seq[Window] {
+	cfg := rangeConfigOf(opts)
+
+	return func(yield func(Window) bool) {
+		for cur := w; !cur.End().Before(from); {
+			if cur.Start().Before(from) && cfg.dropPartial {
+				return
+			}
+			if !yield(cur) {
+				return
+			}
+
+			prev := cur.Prev()
+			if !prev.End().Before(cur.Start()) {
+				c, err := lookupPeriod(PeriodOf(cur))
+				if err != nil {
+					return
+				}
+				prev = c.endingOn(truncateToDay(cur.Start()).AddDate(0, 0, -1))
+			}
+			cur = prev
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package timespan_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		period timespan.Period
+		from   string
+		to     string
+		opts   []timespan.RangeOption
+		want   []span
+	}{
+		{
+			name:   "months up to today",
+			period: timespan.Month,
+			from:   "2026-01-01",
+			to:     "2026-04-17",
+			want: []span{
+				{"2026-01-01", "2026-01-31"},
+				{"2026-02-01", "2026-02-28"},
+				{"2026-03-01", "2026-03-31"},
+				{"2026-04-01", "2026-04-17"},
+			},
+		},
+		{
+			name:   "dropping the trailing partial month",
+			period: timespan.Month,
+			from:   "2026-01-01",
+			to:     "2026-04-17",
+			opts:   []timespan.RangeOption{timespan.RangeDropPartial()},
+			want: []span{
+				{"2026-01-01", "2026-01-31"},
+				{"2026-02-01", "2026-02-28"},
+				{"2026-03-01", "2026-03-31"},
+			},
+		},
+		{
+			name:   "bound on a period end is not partial",
+			period: timespan.Quarter,
+			from:   "2026-01-01",
+			to:     "2026-06-30",
+			opts:   []timespan.RangeOption{timespan.RangeDropPartial()},
+			want: []span{
+				{"2026-01-01", "2026-03-31"},
+				{"2026-04-01", "2026-06-30"},
+			},
+		},
+		{
+			name:   "starting mid period",
+			period: timespan.HalfMonth,
+			from:   "2026-01-10",
+			to:     "2026-02-15",
+			want: []span{
+				{"2026-01-10", "2026-01-15"},
+				{"2026-01-16", "2026-01-31"},
+				{"2026-02-01", "2026-02-15"},
+			},
+		},
+		{
+			name:   "single day",
+			period: timespan.Year,
+			from:   "2026-03-01",
+			to:     "2026-03-01",
+			want:   []span{{"2026-03-01", "2026-03-01"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := mustDate(t, tt.from), mustDate(t, tt.to)
+
+			seq, err := timespan.Range(tt.period, from, to, tt.opts...)
+			if err != nil {
+				t.Fatalf("Range() error = %v", err)
+			}
+			got := slices.Collect(seq)
+			assertSpans(t, got, tt.want)
+
+			rev, err := timespan.RangeReverse(tt.period, from, to, tt.opts...)
+			if err != nil {
+				t.Fatalf("RangeReverse() error = %v", err)
+			}
+			gotRev := slices.Collect(rev)
+			slices.Reverse(gotRev)
+			assertSpans(t, gotRev, tt.want)
+		})
+	}
+}
+
+func TestRange_Errors(t *testing.T) {
+	from, to := mustDate(t, "2026-02-01"), mustDate(t, "2026-01-01")
+
+	if _, err := timespan.Range(timespan.Month, from, to); !errors.Is(err, timespan.ErrEndBeforeStart) {
+		t.Errorf("Range() error = %v, want ErrEndBeforeStart", err)
+	}
+	if _, err := timespan.RangeReverse("decade", to, from); !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("RangeReverse() error = %v, want ErrUnknownPeriod", err)
+	}
+}
+
+func TestRangeFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		window timespan.Window
+		to     string
+		opts   []timespan.RangeOption
+		want   []span
+	}{
+		{
+			name:   "end anchored months",
+			window: timespan.NewMonthWindowEndingOn(mustDate(t, "2026-01-31")),
+			to:     "2026-03-15",
+			want: []span{
+				{"2026-01-01", "2026-01-31"},
+				{"2026-02-01", "2026-02-28"},
+				{"2026-03-01", "2026-03-31"},
+			},
+		},
+		{
+			name:   "dropping the window past the bound",
+			window: timespan.NewMonthWindowEndingOn(mustDate(t, "2026-01-31")),
+			to:     "2026-03-15",
+			opts:   []timespan.RangeOption{timespan.RangeDropPartial()},
+			want: []span{
+				{"2026-01-01", "2026-01-31"},
+				{"2026-02-01", "2026-02-28"},
+			},
+		},
+		{
+			name:   "start anchored partial months keep their offset",
+			window: timespan.NewMonthWindowStartingOn(mustDate(t, "2026-01-10")),
+			to:     "2026-03-01",
+			want: []span{
+				{"2026-01-10", "2026-01-31"},
+				{"2026-02-10", "2026-02-28"},
+			},
+		},
+		{
+			name:   "partial week late in the month",
+			window: timespan.NewWeekWindowStartingOn(mustDate(t, "2026-01-22")),
+			to:     "2026-02-10",
+			want: []span{
+				{"2026-01-22", "2026-01-31"},
+				{"2026-02-01", "2026-02-07"},
+				{"2026-02-08", "2026-02-14"},
+			},
+		},
+		{
+			name:   "single day custom window",
+			window: timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-10")),
+			to:     "2026-03-01",
+			want:   []span{{"2026-01-10", "2026-01-10"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq := timespan.RangeFrom(tt.window, mustDate(t, tt.to), tt.opts...)
+
+			// Ranging twice yields the same windows.
+			assertSpans(t, slices.Collect(seq), tt.want)
+			assertSpans(t, slices.Collect(seq), tt.want)
+		})
+	}
+}
+
+func TestRangeBackFrom(t *testing.T) {
+	q3 := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-07-01"))
+
+	seq := timespan.RangeBackFrom(q3, mustDate(t, "2026-02-15"))
+	want := []span{
+		{"2026-07-01", "2026-09-30"},
+		{"2026-04-01", "2026-06-30"},
+		{"2026-01-01", "2026-03-31"},
+	}
+	assertSpans(t, slices.Collect(seq), want)
+	assertSpans(t, slices.Collect(seq), want)
+
+	day := timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-10"))
+	got := slices.Collect(timespan.RangeBackFrom(day, mustDate(t, "2025-01-01")))
+	assertSpans(t, got, []span{{"2026-01-10", "2026-01-10"}})
+
+	week := timespan.NewWeekWindowEndingOn(mustDate(t, "2026-02-03"))
+	got = slices.Collect(timespan.RangeBackFrom(week, mustDate(t, "2026-01-20")))
+	for i := 1; i < len(got); i++ {
+		if !got[i].End().Before(got[i-1].Start()) {
+			t.Errorf("window %d %v overlaps window %d %v", i, got[i].End(), i-1, got[i-1].Start())
+		}
+	}
+
+	got = slices.Collect(timespan.RangeBackFrom(q3, mustDate(t, "2026-02-15"), timespan.RangeDropPartial()))
+	assertSpans(t, got, []span{
+		{"2026-07-01", "2026-09-30"},
+		{"2026-04-01", "2026-06-30"},
+	})
+}
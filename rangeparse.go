@@ -0,0 +1,246 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrUnrecognizedRange = errors.New("timespan: unrecognized range")
+
+// rangePeriods maps the period words of ParseRange to periods. A plain
+// "week" is the Monday to Sunday ISO week.
+var rangePeriods = map[string]Period{
+	"day":        Day,
+	"week":       ISOWeek,
+	"fortnight":  HalfMonth,
+	"half-month": HalfMonth,
+	"halfmonth":  HalfMonth,
+	"month":      Month,
+	"quarter":    Quarter,
+	"semester":   Semester,
+	"half":       Semester,
+	"half-year":  Semester,
+	"halfyear":   Semester,
+	"year":       Year,
+}
+
+// ParseRange turns a phrase into a window relative to ref. It understands:
+//
+//	today, yesterday, tomorrow
+//	this|current|last|previous|next day|week|fortnight|month|quarter|semester|half|year
+//	last|past|next N days|weeks|months|quarters|years
+//	WTD, MTD, QTD, YTD and week|month|quarter|year to date
+//	Q2 2025, H1 last year, March, mar next year, Q3 this year
+//
+// "this", "last" and "next" name complete periods; "last N" and "next N" are
+// rolling ranges that end or start on ref's day. Period identifiers such as
+// "2026-Q3" and ISO 8601 intervals are accepted too. Phrases are not case
+// sensitive.
+func ParseRange(s string, ref time.Time) (Window, error) {
+	words := strings.Fields(strings.ToLower(s))
+	day := truncateToDay(ref)
+
+	if w, ok := parseRangeWords(words, day); ok {
+		return w, nil
+	}
+
+	trimmed := strings.TrimSpace(s)
+	if w, err := ParseIDInLocation(trimmed, ref.Location()); err == nil {
+		return w, nil
+	}
+	if w, err := ParseIntervalInLocation(trimmed, ref.Location()); err == nil {
+		return w, nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnrecognizedRange, s)
+}
+
+func parseRangeWords(words []string, day time.Time) (Window, bool) {
+	switch strings.Join(words, " ") {
+	case "today":
+		return NewDayWindowStartingOn(day), true
+	case "yesterday":
+		return NewDayWindowStartingOn(day.AddDate(0, 0, -1)), true
+	case "tomorrow":
+		return NewDayWindowStartingOn(day.AddDate(0, 0, 1)), true
+	case "wtd", "week to date":
+		return NewISOWeekWindowEndingOn(day), true
+	case "mtd", "month to date":
+		return NewMonthWindowEndingOn(day), true
+	case "qtd", "quarter to date":
+		return NewQuarterWindowEndingOn(day), true
+	case "ytd", "year to date":
+		return NewYearWindowEndingOn(day), true
+	}
+
+	switch len(words) {
+	case 2:
+		if delta, ok := relativeWord(words[0]); ok {
+			return relativeRange(words[1], delta, day)
+		}
+	case 3:
+		if n, err := strconv.Atoi(words[1]); err == nil && n > 0 {
+			return rollingRange(words[0], n, words[2], day)
+		}
+	}
+
+	return namedRange(words, day)
+}
+
+func relativeWord(w string) (int, bool) {
+	switch w {
+	case "this", "current":
+		return 0, true
+	case "last", "previous", "prev":
+		return -1, true
+	case "next":
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// relativeRange returns the complete period containing day, moved by delta
+// periods. Each step rebuilds the complete period next to the current one
+// from its bounds; Next and Prev on a start-anchored window would keep the
+// day offset, which lands on a single day for half months.
+func relativeRange(word string, delta int, day time.Time) (Window, bool) {
+	p, ok := rangePeriods[word]
+	if !ok {
+		return nil, false
+	}
+
+	c, err := lookupPeriod(p)
+	if err != nil {
+		return nil, false
+	}
+
+	complete := func(t time.Time) Window {
+		return c.startingOn(c.endingOn(t).Start())
+	}
+
+	w := complete(day)
+	for ; delta > 0; delta-- {
+		w = complete(w.End().AddDate(0, 0, 1))
+	}
+	for ; delta < 0; delta++ {
+		w = complete(w.Start().AddDate(0, 0, -1))
+	}
+	return w, true
+}
+
+// maxRollingCount bounds N in "last N" and "next N" so the day and month
+// arithmetic cannot overflow.
+const maxRollingCount = 100000
+
+// rollingRange returns the n days, weeks, months, quarters or years ending
+// on day for "last" and "past", or starting on day for "next".
+func rollingRange(dir string, n int, unit string, day time.Time) (Window, bool) {
+	if n > maxRollingCount {
+		return nil, false
+	}
+
+	var days, months int
+
+	switch strings.TrimSuffix(unit, "s") {
+	case "day":
+		days = n
+	case "week":
+		days = 7 * n
+	case "month":
+		months = n
+	case "quarter":
+		months = 3 * n
+	case "year":
+		months = 12 * n
+	default:
+		return nil, false
+	}
+
+	var start, end time.Time
+	switch dir {
+	case "last", "past":
+		start, end = shiftMonthClamp(day, -months).AddDate(0, 0, 1-days), day
+	case "next":
+		start, end = day, shiftMonthClamp(day, months).AddDate(0, 0, days-1)
+	default:
+		return nil, false
+	}
+
+	w, err := newCustomWindow(start, end)
+	return w, err == nil
+}
+
+// namedRange parses a quarter, half or month followed by an optional year,
+// as in "Q2 2025", "H1 last year" or "march".
+func namedRange(words []string, day time.Time) (Window, bool) {
+	if len(words) == 0 {
+		return nil, false
+	}
+
+	year, ok := rangeYear(words[1:], day)
+	if !ok {
+		return nil, false
+	}
+	loc := day.Location()
+
+	name := words[0]
+	switch {
+	case len(name) == 2 && name[0] == 'q' && name[1] >= '1' && name[1] <= '4':
+		q := int(name[1] - '0')
+		return NewQuarterWindowStartingOn(time.Date(year, time.Month(3*q-2), 1, 0, 0, 0, 0, loc)), true
+	case len(name) == 2 && name[0] == 'h' && (name[1] == '1' || name[1] == '2'):
+		h := int(name[1] - '0')
+		return NewSemesterWindowStartingOn(time.Date(year, time.Month(6*h-5), 1, 0, 0, 0, 0, loc)), true
+	}
+
+	if m, ok := monthNamed(name); ok {
+		return NewMonthWindowStartingOn(time.Date(year, m, 1, 0, 0, 0, 0, loc)), true
+	}
+
+	return nil, false
+}
+
+// monthNamed accepts a month name or an abbreviation of at least three
+// letters.
+func monthNamed(name string) (time.Month, bool) {
+	if name == "sept" {
+		return time.September, true
+	}
+	if len(name) < 3 {
+		return 0, false
+	}
+
+	for m := time.January; m <= time.December; m++ {
+		if strings.HasPrefix(strings.ToLower(m.String()), name) {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+// rangeYear reads the year that follows a named period: a number, "this
+// year", "last year", "next year" or nothing for the year of day.
+func rangeYear(words []string, day time.Time) (int, bool) {
+	switch len(words) {
+	case 0:
+		return day.Year(), true
+	case 1:
+		if len(words[0]) != 4 {
+			return 0, false
+		}
+		y, err := strconv.Atoi(words[0])
+		return y, err == nil
+	case 2:
+		delta, ok := relativeWord(words[0])
+		if !ok || words[1] != "year" {
+			return 0, false
+		}
+		return day.Year() + delta, true
+	default:
+		return 0, false
+	}
+}
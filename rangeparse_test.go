@@ -0,0 +1,108 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestParseRange(t *testing.T) {
+	ref := mustDate(t, "2026-05-20").Add(15 * time.Hour)
+
+	tests := []struct {
+		in        string
+		wantStart string
+		wantEnd   string
+	}{
+		{"today", "2026-05-20", "2026-05-20"},
+		{"Yesterday", "2026-05-19", "2026-05-19"},
+		{"this month", "2026-05-01", "2026-05-31"},
+		{"last month", "2026-04-01", "2026-04-30"},
+		{"previous week", "2026-05-11", "2026-05-17"},
+		{"next week", "2026-05-25", "2026-05-31"},
+		{"this quarter", "2026-04-01", "2026-06-30"},
+		{"last quarter", "2026-01-01", "2026-03-31"},
+		{"next  Semester", "2026-07-01", "2026-12-31"},
+		{"last year", "2025-01-01", "2025-12-31"},
+		{"last fortnight", "2026-05-01", "2026-05-15"},
+		{"last day", "2026-05-19", "2026-05-19"},
+		{"last 7 days", "2026-05-14", "2026-05-20"},
+		{"past 2 weeks", "2026-05-07", "2026-05-20"},
+		{"last 3 months", "2026-02-21", "2026-05-20"},
+		{"last 1 year", "2025-05-21", "2026-05-20"},
+		{"next 10 days", "2026-05-20", "2026-05-29"},
+		{"MTD", "2026-05-01", "2026-05-20"},
+		{"qtd", "2026-04-01", "2026-05-20"},
+		{"year to date", "2026-01-01", "2026-05-20"},
+		{"wtd", "2026-05-18", "2026-05-20"},
+		{"Q2 2025", "2025-04-01", "2025-06-30"},
+		{"q4", "2026-10-01", "2026-12-31"},
+		{"H1 last year", "2025-01-01", "2025-06-30"},
+		{"H2 next year", "2027-07-01", "2027-12-31"},
+		{"March", "2026-03-01", "2026-03-31"},
+		{"sept 2024", "2024-09-01", "2024-09-30"},
+		{"feb this year", "2026-02-01", "2026-02-28"},
+		{"2026-Q3", "2026-07-01", "2026-09-30"},
+		{"2026-01-10/2026-01-20", "2026-01-10", "2026-01-20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			w, err := timespan.ParseRange(tt.in, ref)
+			if err != nil {
+				t.Fatalf("ParseRange(%q): %v", tt.in, err)
+			}
+			assertWindow(t, w, mustDate(t, tt.wantStart), mustDate(t, tt.wantEnd))
+		})
+	}
+}
+
+func TestParseRange_FirstHalfOfMonth(t *testing.T) {
+	tests := []struct {
+		in        string
+		ref       string
+		wantStart string
+		wantEnd   string
+	}{
+		{"last fortnight", "2026-05-10", "2026-04-16", "2026-04-30"},
+		{"last fortnight", "2026-03-01", "2026-02-16", "2026-02-28"},
+		{"this fortnight", "2026-03-01", "2026-03-01", "2026-03-15"},
+		{"next fortnight", "2026-05-10", "2026-05-16", "2026-05-31"},
+		{"next fortnight", "2026-02-20", "2026-03-01", "2026-03-15"},
+		{"last month", "2026-03-31", "2026-02-01", "2026-02-28"},
+		{"last week", "2026-03-02", "2026-02-23", "2026-03-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in+" from "+tt.ref, func(t *testing.T) {
+			w, err := timespan.ParseRange(tt.in, mustDate(t, tt.ref))
+			if err != nil {
+				t.Fatalf("ParseRange(%q): %v", tt.in, err)
+			}
+			assertWindow(t, w, mustDate(t, tt.wantStart), mustDate(t, tt.wantEnd))
+		})
+	}
+}
+
+func TestParseRange_StepsFromResult(t *testing.T) {
+	w, err := timespan.ParseRange("last quarter", mustDate(t, "2026-05-20"))
+	if err != nil {
+		t.Fatalf("ParseRange: %v", err)
+	}
+
+	assertWindow(t, w.Prev(), mustDate(t, "2025-10-01"), mustDate(t, "2025-12-31"))
+	assertWindow(t, w.Next(), mustDate(t, "2026-04-01"), mustDate(t, "2026-06-30"))
+}
+
+func TestParseRange_Errors(t *testing.T) {
+	for _, in := range []string{
+		"", "last", "last decade", "last 0 days", "last -2 days", "Q5 2026", "H1 last decade", "ju", "next 3 fortnights", "sometime soon",
+		"last 9223372036854775807 days", "next 2000000000000000000 weeks", "next 9223372036854775807 years", "last 100001 days",
+	} {
+		if _, err := timespan.ParseRange(in, mustDate(t, "2026-05-20")); !errors.Is(err, timespan.ErrUnrecognizedRange) {
+			t.Errorf("ParseRange(%q) err = %v, want ErrUnrecognizedRange", in, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	ErrUnknownPeriod      = errors.New("timespan: unknown period")
+	ErrPeriodRegistered   = errors.New("timespan: period already registered")
+	ErrInvalidConstructor = errors.New("timespan: invalid period constructor")
+)
+
+type Constructor func(t time.Time) Window
+
+type periodConstructors struct {
+	startingOn Constructor
+	endingOn   Constructor
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[Period]periodConstructors{
+		Day:          {NewDayWindowStartingOn, NewDayWindowEndingOn},
+		Week:         {NewWeekWindowStartingOn, NewWeekWindowEndingOn},
+		ISOWeek:      {NewISOWeekWindowStartingOn, NewISOWeekWindowEndingOn},
+		SundayWeek:   calendarWeekConstructors(time.Sunday),
+		MondayWeek:   calendarWeekConstructors(time.Monday),
+		SaturdayWeek: calendarWeekConstructors(time.Saturday),
+		HalfMonth:    {NewHalfMonthWindowStartingOn, NewHalfMonthWindowEndingOn},
+		Month:        {NewMonthWindowStartingOn, NewMonthWindowEndingOn},
+		Quarter:      {NewQuarterWindowStartingOn, NewQuarterWindowEndingOn},
+		Semester:     {NewSemesterWindowStartingOn, NewSemesterWindowEndingOn},
+		Year:         {NewYearWindowStartingOn, NewYearWindowEndingOn},
+
+		BroadcastWeek:    {NewBroadcastWeekWindowStartingOn, NewBroadcastWeekWindowEndingOn},
+		BroadcastMonth:   {NewBroadcastMonthWindowStartingOn, NewBroadcastMonthWindowEndingOn},
+		BroadcastQuarter: {NewBroadcastQuarterWindowStartingOn, NewBroadcastQuarterWindowEndingOn},
+		BroadcastYear:    {NewBroadcastYearWindowStartingOn, NewBroadcastYearWindowEndingOn},
+	}
+)
+
+// RegisterPeriod makes p available to WindowStartingOn and WindowEndingOn.
+// Every period the package declares is reserved, including Custom and the
+// periods that need a calendar, such as FiscalQuarter or CalendarWeek, and
+// cannot be registered.
+func RegisterPeriod(p Period, startingOn, endingOn Constructor) error {
+	if p == "" {
+		return fmt.Errorf("%w: %q", ErrUnknownPeriod, p)
+	}
+	if slices.Contains(reservedPeriods, p) {
+		return fmt.Errorf("%w: %q", ErrPeriodRegistered, p)
+	}
+	if startingOn == nil || endingOn == nil {
+		return fmt.Errorf("%w: %q", ErrInvalidConstructor, p)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[p]; ok {
+		return fmt.Errorf("%w: %q", ErrPeriodRegistered, p)
+	}
+
+	registry[p] = periodConstructors{startingOn: startingOn, endingOn: endingOn}
+	return nil
+}
+
+func Periods() []Period {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	periods := make([]Period, 0, len(registry))
+	for _, p := range builtinPeriods {
+		if _, ok := registry[p]; ok {
+			periods = append(periods, p)
+		}
+	}
+	var extra []Period
+	for p := range registry {
+		if !isBuiltinPeriod(p) {
+			extra = append(extra, p)
+		}
+	}
+	slices.Sort(extra)
+
+	return append(periods, extra...)
+}
+
+func calendarWeekConstructors(firstDay time.Weekday) periodConstructors {
+	return periodConstructors{
+		startingOn: func(t time.Time) Window { return NewCalendarWeekWindowStartingOn(t, firstDay) },
+		endingOn:   func(t time.Time) Window { return NewCalendarWeekWindowEndingOn(t, firstDay) },
+	}
+}
+
+func lookupPeriod(p Period) (periodConstructors, error) {
+	registryMu.RLock()
+	c, ok := registry[p]
+	registryMu.RUnlock()
+
+	if !ok {
+		return periodConstructors{}, fmt.Errorf("%w: %q", ErrUnknownPeriod, p)
+	}
+	return c, nil
+}
+
+func isRegistered(p Period) bool {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	_, ok := registry[p]
+	return ok
+}
+
+var builtinPeriods = []Period{
+	Day, Week, ISOWeek, SundayWeek, MondayWeek, SaturdayWeek,
+	HalfMonth, Month, Quarter, Semester, Year,
+	BroadcastWeek, BroadcastMonth, BroadcastQuarter, BroadcastYear,
+}
+
+// reservedPeriods are the periods the package declares without registering
+// them, because their windows need a calendar or a first weekday.
+var reservedPeriods = []Period{
+	Custom, CalendarWeek,
+	FiscalMonth, FiscalQuarter, FiscalSemester, FiscalYear,
+	RetailMonth, RetailQuarter, RetailYear,
+	WeekYear, AccountingPeriod, AccountingYear,
+}
+
+func isBuiltinPeriod(p Period) bool {
+	return slices.Contains(builtinPeriods, p)
+}
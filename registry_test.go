@@ -0,0 +1,140 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestWindowStartingOn(t *testing.T) {
+	tests := []struct {
+		name      string
+		period    timespan.Period
+		input     string
+		wantStart string
+		wantEnd   string
+	}{
+		{"week", timespan.Week, "2026-03-10", "2026-03-10", "2026-03-14"},
+		{"half month", timespan.HalfMonth, "2026-03-05", "2026-03-05", "2026-03-15"},
+		{"month", timespan.Month, "2026-03-10", "2026-03-10", "2026-03-31"},
+		{"quarter", timespan.Quarter, "2026-05-10", "2026-05-10", "2026-06-30"},
+		{"semester", timespan.Semester, "2026-02-10", "2026-02-10", "2026-06-30"},
+		{"year", timespan.Year, "2026-03-15", "2026-03-15", "2026-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timespan.WindowStartingOn(tt.period, mustDate(t, tt.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestWindowEndingOn(t *testing.T) {
+	tests := []struct {
+		name      string
+		period    timespan.Period
+		input     string
+		wantStart string
+		wantEnd   string
+	}{
+		{"week", timespan.Week, "2026-03-10", "2026-03-08", "2026-03-10"},
+		{"half month", timespan.HalfMonth, "2026-03-20", "2026-03-16", "2026-03-20"},
+		{"month", timespan.Month, "2026-03-10", "2026-03-01", "2026-03-10"},
+		{"quarter", timespan.Quarter, "2026-05-10", "2026-04-01", "2026-05-10"},
+		{"semester", timespan.Semester, "2026-08-10", "2026-07-01", "2026-08-10"},
+		{"year", timespan.Year, "2026-03-15", "2026-01-01", "2026-03-15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := timespan.WindowEndingOn(tt.period, mustDate(t, tt.input))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
+
+func TestWindowEndingOn_UnknownPeriod(t *testing.T) {
+	for _, p := range []timespan.Period{"", timespan.Custom, "decade"} {
+		w, err := timespan.WindowEndingOn(p, mustDate(t, "2026-03-10"))
+		if !errors.Is(err, timespan.ErrUnknownPeriod) {
+			t.Errorf("period %q: err = %v, want ErrUnknownPeriod", p, err)
+		}
+		if w != nil {
+			t.Errorf("period %q: window = %v, want nil", p, w)
+		}
+	}
+}
+
+func TestRegisterPeriod(t *testing.T) {
+	const fortnight timespan.Period = "test-fortnight"
+
+	startingOn := func(t time.Time) timespan.Window {
+		return timespan.NewCustomWindow(t, t.AddDate(0, 0, 13))
+	}
+	endingOn := func(t time.Time) timespan.Window {
+		return timespan.NewCustomWindow(t.AddDate(0, 0, -13), t)
+	}
+
+	if err := timespan.RegisterPeriod(fortnight, startingOn, endingOn); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if !fortnight.Valid() {
+		t.Errorf("registered period is not valid")
+	}
+
+	got, err := timespan.WindowEndingOn(fortnight, mustDate(t, "2026-03-14"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertWindow(t, got, mustDate(t, "2026-03-01"), mustDate(t, "2026-03-14"))
+
+	err = timespan.RegisterPeriod(fortnight, startingOn, endingOn)
+	if !errors.Is(err, timespan.ErrPeriodRegistered) {
+		t.Errorf("re-register: err = %v, want ErrPeriodRegistered", err)
+	}
+
+	err = timespan.RegisterPeriod(timespan.Month, startingOn, endingOn)
+	if !errors.Is(err, timespan.ErrPeriodRegistered) {
+		t.Errorf("register built-in: err = %v, want ErrPeriodRegistered", err)
+	}
+
+	for _, p := range []timespan.Period{
+		timespan.Custom, timespan.CalendarWeek,
+		timespan.FiscalMonth, timespan.FiscalQuarter, timespan.FiscalSemester, timespan.FiscalYear,
+		timespan.RetailMonth, timespan.RetailQuarter, timespan.RetailYear,
+		timespan.WeekYear, timespan.AccountingPeriod, timespan.AccountingYear,
+	} {
+		if err := timespan.RegisterPeriod(p, startingOn, endingOn); !errors.Is(err, timespan.ErrPeriodRegistered) {
+			t.Errorf("register %q: err = %v, want ErrPeriodRegistered", p, err)
+		}
+		if p.Valid() {
+			t.Errorf("reserved period %q became valid", p)
+		}
+	}
+
+	err = timespan.RegisterPeriod("test-nil", nil, endingOn)
+	if !errors.Is(err, timespan.ErrInvalidConstructor) {
+		t.Errorf("register nil: err = %v, want ErrInvalidConstructor", err)
+	}
+}
@@ -0,0 +1,98 @@
+package timespan
+
+// AllenRelation is one of Allen's thirteen relations between two intervals.
+// Exactly one holds for any pair of windows.
+type AllenRelation int
+
+const (
+	RelationBefore       AllenRelation = iota // a ends at least a day before b starts
+	RelationMeets                             // b starts the day after a ends
+	RelationOverlaps                          // a starts first and ends inside b
+	RelationStarts                            // same first day, a ends first
+	RelationDuring                            // a lies strictly inside b
+	RelationFinishes                          // same last day, a starts later
+	RelationEquals                            // same first and last day
+	RelationFinishedBy                        // same last day, a starts first
+	RelationContains                          // b lies strictly inside a
+	RelationStartedBy                         // same first day, b ends first
+	RelationOverlappedBy                      // b starts first and ends inside a
+	RelationMetBy                             // a starts the day after b ends
+	RelationAfter                             // a starts at least a day after b ends
+)
+
+var relationNames = [...]string{
+	"before", "meets", "overlaps", "starts", "during", "finishes", "equals",
+	"finished-by", "contains", "started-by", "overlapped-by", "met-by", "after",
+}
+
+func (r AllenRelation) String() string {
+	if r < 0 || int(r) >= len(relationNames) {
+		return "unknown"
+	}
+	return relationNames[r]
+}
+
+// Inverse returns the relation of b to a when r is the relation of a to b.
+func (r AllenRelation) Inverse() AllenRelation {
+	return RelationAfter - r
+}
+
+// Relation returns how a relates to b. Windows are compared by whole days
+// with inclusive ends, as ContainsWindow does, so a window ending on
+// January 31 meets one starting on February 1.
+func Relation(a, b Window) AllenRelation {
+	sa, sb := spanOfWindow(a), spanOfWindow(b)
+
+	switch {
+	case sa.end.AddDate(0, 0, 1).Before(sb.start):
+		return RelationBefore
+	case sa.end.AddDate(0, 0, 1).Equal(sb.start):
+		return RelationMeets
+	case sb.end.AddDate(0, 0, 1).Before(sa.start):
+		return RelationAfter
+	case sb.end.AddDate(0, 0, 1).Equal(sa.start):
+		return RelationMetBy
+	}
+
+	startCmp, endCmp := sa.start.Compare(sb.start), sa.end.Compare(sb.end)
+
+	switch {
+	case startCmp == 0 && endCmp == 0:
+		return RelationEquals
+	case startCmp == 0 && endCmp < 0:
+		return RelationStarts
+	case startCmp == 0:
+		return RelationStartedBy
+	case endCmp == 0 && startCmp > 0:
+		return RelationFinishes
+	case endCmp == 0:
+		return RelationFinishedBy
+	case startCmp > 0 && endCmp < 0:
+		return RelationDuring
+	case startCmp < 0 && endCmp > 0:
+		return RelationContains
+	case startCmp < 0:
+		return RelationOverlaps
+	default:
+		return RelationOverlappedBy
+	}
+}
+
+// OverlapDays returns the number of days a and b share.
+func OverlapDays(a, b Window) int {
+	s, ok := spanOfWindow(a).intersect(spanOfWindow(b))
+	if !ok {
+		return 0
+	}
+	return daysBetween(s.start, s.end) + 1
+}
+
+// GapDays returns the number of days strictly between a and b, or 0 when
+// they overlap or meet.
+func GapDays(a, b Window) int {
+	sa, sb := spanOfWindow(a), spanOfWindow(b)
+	if sb.start.Before(sa.start) {
+		sa, sb = sb, sa
+	}
+	return max(daysBetween(sa.end, sb.start)-1, 0)
+}
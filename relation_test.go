@@ -0,0 +1,157 @@
+package timespan_test
+
+import (
+	"testing"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestRelation(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           span
+		b           span
+		want        timespan.AllenRelation
+		wantOverlap int
+		wantGap     int
+	}{
+		{
+			name:    "before",
+			a:       span{"2026-01-01", "2026-01-10"},
+			b:       span{"2026-01-15", "2026-01-20"},
+			want:    timespan.RelationBefore,
+			wantGap: 4,
+		},
+		{
+			name: "meets",
+			a:    span{"2026-01-01", "2026-01-31"},
+			b:    span{"2026-02-01", "2026-02-28"},
+			want: timespan.RelationMeets,
+		},
+		{
+			name:        "overlaps",
+			a:           span{"2026-01-01", "2026-01-20"},
+			b:           span{"2026-01-15", "2026-01-31"},
+			want:        timespan.RelationOverlaps,
+			wantOverlap: 6,
+		},
+		{
+			name:        "overlaps on a single day",
+			a:           span{"2026-01-01", "2026-01-15"},
+			b:           span{"2026-01-15", "2026-01-31"},
+			want:        timespan.RelationOverlaps,
+			wantOverlap: 1,
+		},
+		{
+			name:        "starts",
+			a:           span{"2026-01-01", "2026-01-15"},
+			b:           span{"2026-01-01", "2026-01-31"},
+			want:        timespan.RelationStarts,
+			wantOverlap: 15,
+		},
+		{
+			name:        "during",
+			a:           span{"2026-01-10", "2026-01-20"},
+			b:           span{"2026-01-01", "2026-01-31"},
+			want:        timespan.RelationDuring,
+			wantOverlap: 11,
+		},
+		{
+			name:        "finishes",
+			a:           span{"2026-01-16", "2026-01-31"},
+			b:           span{"2026-01-01", "2026-01-31"},
+			want:        timespan.RelationFinishes,
+			wantOverlap: 16,
+		},
+		{
+			name:        "equals",
+			a:           span{"2026-01-01", "2026-01-31"},
+			b:           span{"2026-01-01", "2026-01-31"},
+			want:        timespan.RelationEquals,
+			wantOverlap: 31,
+		},
+		{
+			name:        "finished by",
+			a:           span{"2026-01-01", "2026-01-31"},
+			b:           span{"2026-01-16", "2026-01-31"},
+			want:        timespan.RelationFinishedBy,
+			wantOverlap: 16,
+		},
+		{
+			name:        "contains",
+			a:           span{"2026-01-01", "2026-01-31"},
+			b:           span{"2026-01-10", "2026-01-20"},
+			want:        timespan.RelationContains,
+			wantOverlap: 11,
+		},
+		{
+			name:        "started by",
+			a:           span{"2026-01-01", "2026-01-31"},
+			b:           span{"2026-01-01", "2026-01-15"},
+			want:        timespan.RelationStartedBy,
+			wantOverlap: 15,
+		},
+		{
+			name:        "overlapped by",
+			a:           span{"2026-01-15", "2026-01-31"},
+			b:           span{"2026-01-01", "2026-01-20"},
+			want:        timespan.RelationOverlappedBy,
+			wantOverlap: 6,
+		},
+		{
+			name: "met by",
+			a:    span{"2026-02-01", "2026-02-28"},
+			b:    span{"2026-01-01", "2026-01-31"},
+			want: timespan.RelationMetBy,
+		},
+		{
+			name:    "after",
+			a:       span{"2026-03-01", "2026-03-31"},
+			b:       span{"2026-01-01", "2026-01-31"},
+			want:    timespan.RelationAfter,
+			wantGap: 28,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := timespan.NewCustomWindow(mustDate(t, tt.a.start), mustDate(t, tt.a.end))
+			b := timespan.NewCustomWindow(mustDate(t, tt.b.start), mustDate(t, tt.b.end))
+
+			if got := timespan.Relation(a, b); got != tt.want {
+				t.Errorf("Relation(a, b) = %v, want %v", got, tt.want)
+			}
+			if got := timespan.Relation(b, a); got != tt.want.Inverse() {
+				t.Errorf("Relation(b, a) = %v, want %v", got, tt.want.Inverse())
+			}
+			if got := timespan.OverlapDays(a, b); got != tt.wantOverlap {
+				t.Errorf("OverlapDays() = %d, want %d", got, tt.wantOverlap)
+			}
+			if got := timespan.GapDays(a, b); got != tt.wantGap {
+				t.Errorf("GapDays() = %d, want %d", got, tt.wantGap)
+			}
+		})
+	}
+}
+
+func TestRelation_PeriodWindows(t *testing.T) {
+	q1 := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-01-01"))
+	march := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-03-01"))
+	april := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-04-01"))
+
+	if got := timespan.Relation(march, q1); got != timespan.RelationFinishes {
+		t.Errorf("Relation(march, q1) = %v, want finishes", got)
+	}
+	if got := timespan.Relation(q1, april); got != timespan.RelationMeets {
+		t.Errorf("Relation(q1, april) = %v, want meets", got)
+	}
+}
+
+func TestAllenRelation_String(t *testing.T) {
+	if got := timespan.RelationOverlappedBy.String(); got != "overlapped-by" {
+		t.Errorf("String() = %q, want %q", got, "overlapped-by")
+	}
+	if got := timespan.AllenRelation(42).String(); got != "unknown" {
+		t.Errorf("String() = %q, want %q", got, "unknown")
+	}
+}
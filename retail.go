@@ -0,0 +1,286 @@
+package timespan
+
+import "time"
+
+const (
+	RetailMonth   Period = "retailmonth"
+	RetailQuarter Period = "retailquarter"
+	RetailYear    Period = "retailyear"
+)
+
+// RetailPattern is the number of weeks in each month of a retail quarter.
+type RetailPattern int
+
+const (
+	Pattern445 RetailPattern = 0
+	Pattern454 RetailPattern = 1
+	Pattern544 RetailPattern = 2
+)
+
+func (p RetailPattern) weeks() [3]int {
+	switch p {
+	case Pattern454:
+		return [3]int{4, 5, 4}
+	case Pattern544:
+		return [3]int{5, 4, 4}
+	default:
+		return [3]int{4, 4, 5}
+	}
+}
+
+// LeapWeekRule says which month gets the 53rd week of a long year.
+type LeapWeekRule int
+
+const (
+	LeapWeekLastMonth  LeapWeekRule = 0
+	LeapWeekFirstMonth LeapWeekRule = 1
+)
+
+// RetailCalendar is a 52/53-week year split into quarters of 13 weeks, whose
+// months follow Pattern.
+type RetailCalendar struct {
+	Pattern  RetailPattern `json:"pattern"`
+	YearEnd  YearEnd       `json:"yearEnd"`
+	LeapWeek LeapWeekRule  `json:"leapWeek"`
+}
+
+// valid reports whether the fields of c are in range.
+func (c RetailCalendar) valid() bool {
+	return c.Pattern >= Pattern445 && c.Pattern <= Pattern544 &&
+		(c.LeapWeek == LeapWeekLastMonth || c.LeapWeek == LeapWeekFirstMonth) &&
+		c.YearEnd.valid()
+}
+
+// monthWeeks returns the length in weeks of each month of the rule year y.
+func (c RetailCalendar) monthWeeks(y int, loc *time.Location) [12]int {
+	var months [12]int
+
+	pattern := c.Pattern.weeks()
+	for i := range months {
+		months[i] = pattern[i%3]
+	}
+
+	if c.YearEnd.weeks(y, loc) == 53 {
+		if c.LeapWeek == LeapWeekFirstMonth {
+			months[0]++
+		} else {
+			months[11]++
+		}
+	}
+
+	return months
+}
+
+// months returns the first and last day of every month of the retail year
+// containing t.
+func (c RetailCalendar) months(t time.Time) [12][2]time.Time {
+	var months [12][2]time.Time
+
+	start, _ := c.YearEnd.bounds(t)
+	for i, weeks := range c.monthWeeks(c.YearEnd.yearOf(t), t.Location()) {
+		end := start.AddDate(0, 0, 7*weeks-1)
+		months[i] = [2]time.Time{start, end}
+		start = end.AddDate(0, 0, 1)
+	}
+
+	return months
+}
+
+// month returns the 1-based number of the retail month containing t.
+func (c RetailCalendar) month(t time.Time) int {
+	t = truncateToDay(t)
+
+	months := c.months(t)
+	for i, m := range months {
+		if !t.After(m[1]) {
+			return i + 1
+		}
+	}
+	return 12
+}
+
+func (c RetailCalendar) bounds(p Period, t time.Time) (start, end time.Time) {
+	months := c.months(t)
+	month := c.month(t)
+
+	switch p {
+	case RetailMonth:
+		return months[month-1][0], months[month-1][1]
+	case RetailQuarter:
+		first := (month - 1) / 3 * 3
+		return months[first][0], months[first+2][1]
+	default:
+		return months[0][0], months[11][1]
+	}
+}
+
+func (c RetailCalendar) index(p Period, t time.Time) int {
+	switch p {
+	case RetailMonth:
+		return c.month(t)
+	case RetailQuarter:
+		return (c.month(t)-1)/3 + 1
+	default:
+		return 1
+	}
+}
+
+// RetailWindow is a month, quarter or year of a RetailCalendar.
+type RetailWindow struct {
+	start           time.Time
+	end             time.Time
+	anchor          Anchor
+	shouldBeLastDay bool
+	period          Period
+	calendar        RetailCalendar
+}
+
+func (r *RetailWindow) Index() int {
+	return r.calendar.index(r.period, r.end)
+}
+
+func (r *RetailWindow) RetailYear() int {
+	return r.calendar.YearEnd.FiscalYear(r.end)
+}
+
+func (r *RetailWindow) Period() Period           { return r.period }
+func (r *RetailWindow) Calendar() RetailCalendar { return r.calendar }
+
+func (r *RetailWindow) Validate() error {
+	return validateSpan(r.period, r.anchor, r.start, r.end, r.spanFunc())
+}
+
+func (r *RetailWindow) Start() time.Time { return r.start }
+func (r *RetailWindow) SetStart(t time.Time) {
+	r.start = t
+	if r.anchor == StartAnchor {
+		r.shouldBeLastDay = isSpanEnd(r.spanFunc(), t)
+	}
+}
+
+func (r *RetailWindow) End() time.Time { return r.end }
+func (r *RetailWindow) SetEnd(t time.Time) {
+	r.end = t
+	if r.anchor == EndAnchor {
+		r.shouldBeLastDay = isSpanEnd(r.spanFunc(), t)
+	}
+}
+
+func (r *RetailWindow) WithStart(t time.Time) Window {
+	c := *r
+	c.SetStart(t)
+	return &c
+}
+
+func (r *RetailWindow) WithEnd(t time.Time) Window {
+	c := *r
+	c.SetEnd(t)
+	return &c
+}
+
+func (r *RetailWindow) Next(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return r.shift(retailPeriodsPerYear(r.period))
+	}
+
+	return r.shift(1)
+}
+
+func (r *RetailWindow) Prev(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return r.shift(-retailPeriodsPerYear(r.period))
+	}
+
+	return r.shift(-1)
+}
+
+func (r *RetailWindow) Complete() Window {
+	ref := r.end
+	if r.anchor == StartAnchor {
+		ref = r.start
+	}
+
+	start, end := r.calendar.bounds(r.period, ref)
+
+	return &RetailWindow{
+		start:           start,
+		end:             end,
+		anchor:          r.anchor,
+		shouldBeLastDay: true,
+		period:          r.period,
+		calendar:        r.calendar,
+	}
+}
+
+func (r *RetailWindow) shift(delta int) Window {
+	ref := r.end
+	if r.anchor == StartAnchor {
+		ref = r.start
+	}
+
+	ref = shiftSpan(r.spanFunc(), ref, delta, r.shouldBeLastDay)
+
+	return newRetailWindow(r.calendar, r.period, r.anchor, ref)
+}
+
+func (r *RetailWindow) spanFunc() spanFunc {
+	return func(t time.Time) (time.Time, time.Time) {
+		return r.calendar.bounds(r.period, t)
+	}
+}
+
+func NewRetailMonthWindowStartingOn(c RetailCalendar, t time.Time) Window {
+	return newRetailWindow(c, RetailMonth, StartAnchor, t)
+}
+
+func NewRetailMonthWindowEndingOn(c RetailCalendar, t time.Time) Window {
+	return newRetailWindow(c, RetailMonth, EndAnchor, t)
+}
+
+func NewRetailQuarterWindowStartingOn(c RetailCalendar, t time.Time) Window {
+	return newRetailWindow(c, RetailQuarter, StartAnchor, t)
+}
+
+func NewRetailQuarterWindowEndingOn(c RetailCalendar, t time.Time) Window {
+	return newRetailWindow(c, RetailQuarter, EndAnchor, t)
+}
+
+func NewRetailYearWindowStartingOn(c RetailCalendar, t time.Time) Window {
+	return newRetailWindow(c, RetailYear, StartAnchor, t)
+}
+
+func NewRetailYearWindowEndingOn(c RetailCalendar, t time.Time) Window {
+	return newRetailWindow(c, RetailYear, EndAnchor, t)
+}
+
+func newRetailWindow(c RetailCalendar, p Period, a Anchor, t time.Time) Window {
+	start, end := c.bounds(p, t)
+
+	w := &RetailWindow{
+		start:           start,
+		end:             end,
+		anchor:          a,
+		shouldBeLastDay: truncateToDay(t).Equal(end),
+		period:          p,
+		calendar:        c,
+	}
+
+	if a == StartAnchor {
+		w.start = truncateToDay(t)
+	} else {
+		w.end = truncateToDay(t)
+	}
+
+	return w
+}
+
+func retailPeriodsPerYear(p Period) int {
+	switch p {
+	case RetailMonth:
+		return 12
+	case RetailQuarter:
+		return 4
+	default:
+		return 1
+	}
+}
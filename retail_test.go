@@ -0,0 +1,176 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+var (
+	nrf = timespan.RetailCalendar{
+		Pattern: timespan.Pattern454,
+		YearEnd: timespan.YearEnd{
+			Month:   time.January,
+			Weekday: time.Saturday,
+			Method:  timespan.NearestWeekday,
+			Naming:  timespan.FiscalYearNamedByStartYear,
+		},
+	}
+	retail445 = timespan.RetailCalendar{
+		Pattern: timespan.Pattern445,
+		YearEnd: timespan.YearEnd{Month: time.December, Weekday: time.Saturday},
+	}
+)
+
+func TestRetailWindow_Complete(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         timespan.Window
+		wantStart string
+		wantEnd   string
+		wantIndex int
+		wantYear  int
+	}{
+		{
+			name:      "nrf year",
+			w:         timespan.NewRetailYearWindowEndingOn(nrf, mustDate(t, "2025-06-10")),
+			wantStart: "2025-02-02",
+			wantEnd:   "2026-01-31",
+			wantIndex: 1,
+			wantYear:  2025,
+		},
+		{
+			name:      "nrf 53 week year",
+			w:         timespan.NewRetailYearWindowEndingOn(nrf, mustDate(t, "2023-06-10")),
+			wantStart: "2023-01-29",
+			wantEnd:   "2024-02-03",
+			wantIndex: 1,
+			wantYear:  2023,
+		},
+		{
+			name:      "4-5-4 second month has five weeks",
+			w:         timespan.NewRetailMonthWindowStartingOn(nrf, mustDate(t, "2025-03-10")),
+			wantStart: "2025-03-02",
+			wantEnd:   "2025-04-05",
+			wantIndex: 2,
+			wantYear:  2025,
+		},
+		{
+			name:      "leap week goes to last month",
+			w:         timespan.NewRetailMonthWindowEndingOn(nrf, mustDate(t, "2024-01-15")),
+			wantStart: "2023-12-31",
+			wantEnd:   "2024-02-03",
+			wantIndex: 12,
+			wantYear:  2023,
+		},
+		{
+			name:      "4-5-4 first quarter",
+			w:         timespan.NewRetailQuarterWindowEndingOn(nrf, mustDate(t, "2025-04-20")),
+			wantStart: "2025-02-02",
+			wantEnd:   "2025-05-03",
+			wantIndex: 1,
+			wantYear:  2025,
+		},
+		{
+			name:      "4-4-5 third month",
+			w:         timespan.NewRetailMonthWindowEndingOn(retail445, mustDate(t, "2026-03-01")),
+			wantStart: "2026-02-22",
+			wantEnd:   "2026-03-28",
+			wantIndex: 3,
+			wantYear:  2026,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.w.Complete()
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+
+			if idx := got.Index(); idx != tt.wantIndex {
+				t.Errorf("index = %d, want %d", idx, tt.wantIndex)
+			}
+			if y := got.(*timespan.RetailWindow).RetailYear(); y != tt.wantYear {
+				t.Errorf("retail year = %d, want %d", y, tt.wantYear)
+			}
+		})
+	}
+}
+
+func TestRetailWindow_LeapWeekFirstMonth(t *testing.T) {
+	c := nrf
+	c.LeapWeek = timespan.LeapWeekFirstMonth
+
+	w := timespan.NewRetailMonthWindowEndingOn(c, mustDate(t, "2023-02-01")).Complete()
+
+	assertWindow(t, w, mustDate(t, "2023-01-29"), mustDate(t, "2023-03-04"))
+}
+
+func TestRetailWindow_NextPrev(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         timespan.Window
+		next      bool
+		step      []timespan.Step
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name:      "month to date keeps day offset",
+			w:         timespan.NewRetailMonthWindowEndingOn(retail445, mustDate(t, "2026-01-10")),
+			next:      true,
+			wantStart: "2026-01-25",
+			wantEnd:   "2026-02-07",
+		},
+		{
+			name:      "month end snaps to longer month end",
+			w:         timespan.NewRetailMonthWindowEndingOn(retail445, mustDate(t, "2026-02-21")),
+			next:      true,
+			wantStart: "2026-02-22",
+			wantEnd:   "2026-03-28",
+		},
+		{
+			name:      "offset clamped into shorter month",
+			w:         timespan.NewRetailMonthWindowEndingOn(retail445, mustDate(t, "2026-03-27")),
+			next:      true,
+			wantStart: "2026-03-29",
+			wantEnd:   "2026-04-25",
+		},
+		{
+			name:      "quarter back across year",
+			w:         timespan.NewRetailQuarterWindowStartingOn(nrf, mustDate(t, "2025-02-02")),
+			wantStart: "2024-11-03",
+			wantEnd:   "2025-02-01",
+		},
+		{
+			name:      "month year step",
+			w:         timespan.NewRetailMonthWindowStartingOn(nrf, mustDate(t, "2025-03-02")),
+			next:      true,
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2026-03-01",
+			wantEnd:   "2026-04-04",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.w.Prev(tt.step...)
+			if tt.next {
+				got = tt.w.Next(tt.step...)
+			}
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+		})
+	}
+}
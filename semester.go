@@ -10,12 +10,13 @@ type HalfYearWindow struct {
 }
 
 func (s *HalfYearWindow) Index() int {
-	_, m, _ := s.end.Date()
+	return civilIndex(Semester, s.end)
+}
 
-	if m <= 6 {
-		return 0
-	}
-	return 1
+func (s *HalfYearWindow) Period() Period { return Semester }
+
+func (s *HalfYearWindow) Validate() error {
+	return validateSpan(Semester, s.anchor, s.start, s.end, spanOf(semesterStart, semesterEnd))
 }
 
 func (s *HalfYearWindow) Start() time.Time { return s.start }
@@ -33,6 +34,18 @@ func (s *HalfYearWindow) SetEnd(t time.Time) {
 	}
 }
 
+func (s *HalfYearWindow) WithStart(t time.Time) Window {
+	c := *s
+	c.SetStart(t)
+	return &c
+}
+
+func (s *HalfYearWindow) WithEnd(t time.Time) Window {
+	c := *s
+	c.SetEnd(t)
+	return &c
+}
+
 func NewSemesterWindowStartingOn(t time.Time) Window {
 	return &HalfYearWindow{
 		start:           truncateToDay(t),
@@ -0,0 +1,264 @@
+package timespan
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// The set operations treat a window as the whole days from the day of its
+// start through the day of its end, the same days Days yields, so a window
+// that ends before it starts has no days. Results are CustomWindows from the
+// first to the last day of each span.
+
+// daySpan is a run of whole days, both ends inclusive.
+type daySpan struct {
+	start time.Time
+	end   time.Time
+}
+
+func spanOfWindow(w Window) daySpan {
+	return daySpan{start: truncateToDay(w.Start()), end: truncateToDay(w.End())}
+}
+
+// empty reports whether s has no days, as for a window that ends before it
+// starts.
+func (s daySpan) empty() bool {
+	return s.end.Before(s.start)
+}
+
+func (s daySpan) window() Window {
+	return NewCustomWindow(s.start, s.end)
+}
+
+// touches reports whether s and o overlap or are adjacent days.
+func (s daySpan) touches(o daySpan) bool {
+	return !o.start.After(s.end.AddDate(0, 0, 1)) && !s.start.After(o.end.AddDate(0, 0, 1))
+}
+
+func (s daySpan) intersect(o daySpan) (daySpan, bool) {
+	r := daySpan{start: laterOf(s.start, o.start), end: earlierOf(s.end, o.end)}
+	return r, !r.end.Before(r.start)
+}
+
+// subtract returns the days of s outside o.
+func (s daySpan) subtract(o daySpan) []daySpan {
+	if s.empty() {
+		return nil
+	}
+	if _, ok := s.intersect(o); !ok {
+		return []daySpan{s}
+	}
+
+	var out []daySpan
+	if s.start.Before(o.start) {
+		out = append(out, daySpan{start: s.start, end: o.start.AddDate(0, 0, -1)})
+	}
+	if s.end.After(o.end) {
+		out = append(out, daySpan{start: o.end.AddDate(0, 0, 1), end: s.end})
+	}
+	return out
+}
+
+// Overlaps reports whether a and b share at least one day.
+func Overlaps(a, b Window) bool {
+	_, ok := spanOfWindow(a).intersect(spanOfWindow(b))
+	return ok
+}
+
+// Intersect returns the days a and b have in common, or false when they
+// share none.
+func Intersect(a, b Window) (Window, bool) {
+	s, ok := spanOfWindow(a).intersect(spanOfWindow(b))
+	if !ok {
+		return nil, false
+	}
+	return s.window(), true
+}
+
+// Union returns the days of a and b as one window, or false when a gap
+// separates them. Use a WindowSet to combine windows that may not touch.
+func Union(a, b Window) (Window, bool) {
+	sa, sb := spanOfWindow(a), spanOfWindow(b)
+	switch {
+	case sa.empty() && sb.empty():
+		return nil, false
+	case sa.empty():
+		return sb.window(), true
+	case sb.empty():
+		return sa.window(), true
+	}
+	if !sa.touches(sb) {
+		return nil, false
+	}
+	return daySpan{start: earlierOf(sa.start, sb.start), end: laterOf(sa.end, sb.end)}.window(), true
+}
+
+// Difference returns the days of a that are not in b: none, one window, or
+// two when b lies strictly inside a.
+func Difference(a, b Window) []Window {
+	return spanWindows(spanOfWindow(a).subtract(spanOfWindow(b)))
+}
+
+// WindowSet is a set of days kept as sorted windows that neither overlap nor
+// touch. The zero value is an empty set. Like windows, sets are values:
+// methods return new sets and never modify the receiver.
+type WindowSet struct {
+	spans []daySpan
+}
+
+// NewWindowSet returns the set of days covered by any of ws, merging
+// windows that overlap or are adjacent.
+func NewWindowSet(ws ...Window) WindowSet {
+	return WindowSet{}.Add(ws...)
+}
+
+// Add returns the set with the days of ws added.
+func (s WindowSet) Add(ws ...Window) WindowSet {
+	spans := slices.Clone(s.spans)
+	for _, w := range ws {
+		if sp := spanOfWindow(w); !sp.empty() {
+			spans = append(spans, sp)
+		}
+	}
+	return WindowSet{spans: mergeSpans(spans)}
+}
+
+// Remove returns the set without the days of ws.
+func (s WindowSet) Remove(ws ...Window) WindowSet {
+	spans := slices.Clone(s.spans)
+	for _, w := range ws {
+		o := spanOfWindow(w)
+
+		var next []daySpan
+		for _, sp := range spans {
+			next = append(next, sp.subtract(o)...)
+		}
+		spans = next
+	}
+	return WindowSet{spans: spans}
+}
+
+// Clip returns the days of the set that fall inside w.
+func (s WindowSet) Clip(w Window) WindowSet {
+	o := spanOfWindow(w)
+
+	var spans []daySpan
+	for _, sp := range s.spans {
+		if r, ok := sp.intersect(o); ok {
+			spans = append(spans, r)
+		}
+	}
+	return WindowSet{spans: spans}
+}
+
+// Union returns the days in either set.
+func (s WindowSet) Union(o WindowSet) WindowSet {
+	return WindowSet{spans: mergeSpans(append(slices.Clone(s.spans), o.spans...))}
+}
+
+// Windows returns the merged windows in order.
+func (s WindowSet) Windows() []Window {
+	return spanWindows(s.spans)
+}
+
+// Gaps returns the runs of days between consecutive windows of the set.
+func (s WindowSet) Gaps() []Window {
+	var gaps []daySpan
+	for i := 1; i < len(s.spans); i++ {
+		gaps = append(gaps, daySpan{
+			start: s.spans[i-1].end.AddDate(0, 0, 1),
+			end:   s.spans[i].start.AddDate(0, 0, -1),
+		})
+	}
+	return spanWindows(gaps)
+}
+
+// Uncovered returns the days of w that are not in the set, such as the
+// days of a quarter not covered by any contract.
+func (s WindowSet) Uncovered(w Window) []Window {
+	return NewWindowSet(w).Remove(s.Windows()...).Windows()
+}
+
+// ContainsTime reports whether the day of t is in the set.
+func (s WindowSet) ContainsTime(t time.Time) bool {
+	day := truncateToDay(t)
+	for _, sp := range s.spans {
+		if !day.Before(sp.start) && !day.After(sp.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of windows in the set.
+func (s WindowSet) Len() int {
+	return len(s.spans)
+}
+
+// DayCount returns the number of days in the set.
+func (s WindowSet) DayCount() int {
+	n := 0
+	for _, sp := range s.spans {
+		n += daysBetween(sp.start, sp.end) + 1
+	}
+	return n
+}
+
+// Days yields every day of the set in order.
+func (s WindowSet) Days() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		for _, sp := range s.spans {
+			for d := sp.start; !d.After(sp.end); d = d.AddDate(0, 0, 1) {
+				if !yield(d) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func mergeSpans(spans []daySpan) []daySpan {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(spans, func(a, b daySpan) int { return a.start.Compare(b.start) })
+
+	merged := spans[:1]
+	for _, sp := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if last.touches(sp) {
+			last.end = laterOf(last.end, sp.end)
+			continue
+		}
+		merged = append(merged, sp)
+	}
+	return merged
+}
+
+func spanWindows(spans []daySpan) []Window {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	ws := make([]Window, len(spans))
+	for i, sp := range spans {
+		ws[i] = sp.window()
+	}
+	return ws
+}
+
+func earlierOf(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,338 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+type span struct {
+	start string
+	end   string
+}
+
+func assertSpans(t *testing.T, got []timespan.Window, want []span) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d windows, want %d: %v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		assertWindow(t, got[i], mustDate(t, w.start), mustDate(t, w.end))
+	}
+}
+
+func TestOverlapsAndIntersect(t *testing.T) {
+	tests := []struct {
+		name string
+		a    span
+		b    span
+		want *span
+	}{
+		{
+			name: "partial overlap",
+			a:    span{"2026-01-01", "2026-01-20"},
+			b:    span{"2026-01-15", "2026-02-10"},
+			want: &span{"2026-01-15", "2026-01-20"},
+		},
+		{
+			name: "one inside the other",
+			a:    span{"2026-01-01", "2026-03-31"},
+			b:    span{"2026-02-01", "2026-02-28"},
+			want: &span{"2026-02-01", "2026-02-28"},
+		},
+		{
+			name: "sharing a single day",
+			a:    span{"2026-01-01", "2026-01-15"},
+			b:    span{"2026-01-15", "2026-01-31"},
+			want: &span{"2026-01-15", "2026-01-15"},
+		},
+		{
+			name: "adjacent",
+			a:    span{"2026-01-01", "2026-01-15"},
+			b:    span{"2026-01-16", "2026-01-31"},
+		},
+		{
+			name: "disjoint",
+			a:    span{"2026-01-01", "2026-01-10"},
+			b:    span{"2026-03-01", "2026-03-10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := timespan.NewCustomWindow(mustDate(t, tt.a.start), mustDate(t, tt.a.end))
+			b := timespan.NewCustomWindow(mustDate(t, tt.b.start), mustDate(t, tt.b.end))
+
+			if got := timespan.Overlaps(a, b); got != (tt.want != nil) {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want != nil)
+			}
+
+			got, ok := timespan.Intersect(a, b)
+			if ok != (tt.want != nil) {
+				t.Fatalf("Intersect() ok = %v, want %v", ok, tt.want != nil)
+			}
+			if ok {
+				assertWindow(t, got, mustDate(t, tt.want.start), mustDate(t, tt.want.end))
+			}
+		})
+	}
+}
+
+func TestIntersect_TimeOfDay(t *testing.T) {
+	a := timespan.NewCustomWindow(
+		time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 10, 17, 0, 0, 0, time.UTC),
+	)
+	b := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-01-10"))
+
+	got, ok := timespan.Intersect(a, b)
+	if !ok {
+		t.Fatal("Intersect() ok = false, want true")
+	}
+	assertWindow(t, got, mustDate(t, "2026-01-10"), mustDate(t, "2026-01-10"))
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a    span
+		b    span
+		want *span
+	}{
+		{
+			name: "overlapping",
+			a:    span{"2026-01-01", "2026-01-20"},
+			b:    span{"2026-01-15", "2026-02-10"},
+			want: &span{"2026-01-01", "2026-02-10"},
+		},
+		{
+			name: "adjacent",
+			a:    span{"2026-02-01", "2026-02-28"},
+			b:    span{"2026-01-01", "2026-01-31"},
+			want: &span{"2026-01-01", "2026-02-28"},
+		},
+		{
+			name: "separated by a day",
+			a:    span{"2026-01-01", "2026-01-30"},
+			b:    span{"2026-02-01", "2026-02-28"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := timespan.NewCustomWindow(mustDate(t, tt.a.start), mustDate(t, tt.a.end))
+			b := timespan.NewCustomWindow(mustDate(t, tt.b.start), mustDate(t, tt.b.end))
+
+			got, ok := timespan.Union(a, b)
+			if ok != (tt.want != nil) {
+				t.Fatalf("Union() ok = %v, want %v", ok, tt.want != nil)
+			}
+			if ok {
+				assertWindow(t, got, mustDate(t, tt.want.start), mustDate(t, tt.want.end))
+			}
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a    span
+		b    span
+		want []span
+	}{
+		{
+			name: "hole in the middle",
+			a:    span{"2026-01-01", "2026-01-31"},
+			b:    span{"2026-01-10", "2026-01-20"},
+			want: []span{{"2026-01-01", "2026-01-09"}, {"2026-01-21", "2026-01-31"}},
+		},
+		{
+			name: "cut the end",
+			a:    span{"2026-01-01", "2026-01-31"},
+			b:    span{"2026-01-20", "2026-02-15"},
+			want: []span{{"2026-01-01", "2026-01-19"}},
+		},
+		{
+			name: "cut the start",
+			a:    span{"2026-01-01", "2026-01-31"},
+			b:    span{"2025-12-01", "2026-01-01"},
+			want: []span{{"2026-01-02", "2026-01-31"}},
+		},
+		{
+			name: "fully covered",
+			a:    span{"2026-01-10", "2026-01-20"},
+			b:    span{"2026-01-01", "2026-01-31"},
+		},
+		{
+			name: "disjoint",
+			a:    span{"2026-01-01", "2026-01-31"},
+			b:    span{"2026-03-01", "2026-03-31"},
+			want: []span{{"2026-01-01", "2026-01-31"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := timespan.NewCustomWindow(mustDate(t, tt.a.start), mustDate(t, tt.a.end))
+			b := timespan.NewCustomWindow(mustDate(t, tt.b.start), mustDate(t, tt.b.end))
+
+			assertSpans(t, timespan.Difference(a, b), tt.want)
+		})
+	}
+}
+
+func TestWindowSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		windows  []span
+		want     []span
+		wantGaps []span
+		wantDays int
+	}{
+		{
+			name:     "empty",
+			wantDays: 0,
+		},
+		{
+			name:     "merges overlapping and adjacent windows",
+			windows:  []span{{"2026-02-01", "2026-02-28"}, {"2026-01-01", "2026-01-31"}, {"2026-02-20", "2026-03-05"}},
+			want:     []span{{"2026-01-01", "2026-03-05"}},
+			wantDays: 64,
+		},
+		{
+			name:     "keeps separated windows apart",
+			windows:  []span{{"2026-03-01", "2026-03-10"}, {"2026-01-01", "2026-01-10"}, {"2026-01-05", "2026-01-20"}},
+			want:     []span{{"2026-01-01", "2026-01-20"}, {"2026-03-01", "2026-03-10"}},
+			wantGaps: []span{{"2026-01-21", "2026-02-28"}},
+			wantDays: 30,
+		},
+		{
+			name:     "window inside another",
+			windows:  []span{{"2026-01-01", "2026-12-31"}, {"2026-06-01", "2026-06-30"}},
+			want:     []span{{"2026-01-01", "2026-12-31"}},
+			wantDays: 365,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ws []timespan.Window
+			for _, s := range tt.windows {
+				ws = append(ws, timespan.NewCustomWindow(mustDate(t, s.start), mustDate(t, s.end)))
+			}
+
+			set := timespan.NewWindowSet(ws...)
+			assertSpans(t, set.Windows(), tt.want)
+			assertSpans(t, set.Gaps(), tt.wantGaps)
+
+			if set.Len() != len(tt.want) {
+				t.Errorf("Len() = %d, want %d", set.Len(), len(tt.want))
+			}
+			if got := set.DayCount(); got != tt.wantDays {
+				t.Errorf("DayCount() = %d, want %d", got, tt.wantDays)
+			}
+
+			days := 0
+			for range set.Days() {
+				days++
+			}
+			if days != tt.wantDays {
+				t.Errorf("Days() yielded %d days, want %d", days, tt.wantDays)
+			}
+		})
+	}
+}
+
+func TestWindowSet_Uncovered(t *testing.T) {
+	contracts := timespan.NewWindowSet(
+		timespan.NewCustomWindow(mustDate(t, "2025-12-15"), mustDate(t, "2026-01-20")),
+		timespan.NewCustomWindow(mustDate(t, "2026-02-01"), mustDate(t, "2026-02-28")),
+		timespan.NewCustomWindow(mustDate(t, "2026-03-10"), mustDate(t, "2026-03-15")),
+	)
+	quarter := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	assertSpans(t, contracts.Uncovered(quarter), []span{
+		{"2026-01-21", "2026-01-31"},
+		{"2026-03-01", "2026-03-09"},
+		{"2026-03-16", "2026-03-31"},
+	})
+
+	covered := contracts.Clip(quarter)
+	assertSpans(t, covered.Windows(), []span{
+		{"2026-01-01", "2026-01-20"},
+		{"2026-02-01", "2026-02-28"},
+		{"2026-03-10", "2026-03-15"},
+	})
+	if got := covered.DayCount(); got != 54 {
+		t.Errorf("Clip().DayCount() = %d, want 54", got)
+	}
+}
+
+func TestWindowSet_AddRemove(t *testing.T) {
+	base := timespan.NewWindowSet(timespan.NewMonthWindowStartingOn(mustDate(t, "2026-01-01")))
+
+	removed := base.Remove(
+		timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-12")),
+		timespan.NewCustomWindow(mustDate(t, "2026-01-30"), mustDate(t, "2026-02-05")),
+	)
+	assertSpans(t, removed.Windows(), []span{
+		{"2026-01-01", "2026-01-09"},
+		{"2026-01-13", "2026-01-29"},
+	})
+
+	added := removed.Add(timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-12")))
+	assertSpans(t, added.Windows(), []span{{"2026-01-01", "2026-01-29"}})
+
+	union := removed.Union(timespan.NewWindowSet(timespan.NewMonthWindowStartingOn(mustDate(t, "2026-02-01"))))
+	assertSpans(t, union.Windows(), []span{
+		{"2026-01-01", "2026-01-09"},
+		{"2026-01-13", "2026-01-29"},
+		{"2026-02-01", "2026-02-28"},
+	})
+
+	// The receiver is never modified.
+	assertSpans(t, base.Windows(), []span{{"2026-01-01", "2026-01-31"}})
+
+	if !removed.ContainsTime(time.Date(2026, 1, 9, 23, 0, 0, 0, time.UTC)) {
+		t.Error("ContainsTime(Jan 9 23:00) = false, want true")
+	}
+	if removed.ContainsTime(mustDate(t, "2026-01-11")) {
+		t.Error("ContainsTime(Jan 11) = true, want false")
+	}
+}
+
+func TestSetOperations_EndBeforeStart(t *testing.T) {
+	month := timespan.NewMonthWindowStartingOn(mustDate(t, "2026-01-01"))
+	inverted := mustWithEnd(t, timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-12")), mustDate(t, "2026-01-05"))
+
+	if got := timespan.NewWindowSet(inverted).Windows(); len(got) != 0 {
+		t.Errorf("NewWindowSet(inverted).Windows() = %v, want none", got)
+	}
+	assertSpans(t, timespan.NewWindowSet(month, inverted).Windows(), []span{{"2026-01-01", "2026-01-31"}})
+	assertSpans(t, timespan.NewWindowSet(month).Remove(inverted).Windows(), []span{{"2026-01-01", "2026-01-31"}})
+
+	if timespan.Overlaps(month, inverted) {
+		t.Error("Overlaps() = true, want false")
+	}
+	if w, ok := timespan.Intersect(month, inverted); ok {
+		t.Errorf("Intersect() = %v, want none", w)
+	}
+	if w, ok := timespan.Union(inverted, month); !ok {
+		t.Error("Union() = false, want the month")
+	} else {
+		assertWindow(t, w, mustDate(t, "2026-01-01"), mustDate(t, "2026-01-31"))
+	}
+	if _, ok := timespan.Union(inverted, inverted); ok {
+		t.Error("Union(inverted, inverted) = true, want false")
+	}
+	if got := timespan.Difference(inverted, month); len(got) != 0 {
+		t.Errorf("Difference(inverted, month) = %v, want none", got)
+	}
+	assertSpans(t, timespan.Difference(month, inverted), []span{{"2026-01-01", "2026-01-31"}})
+	if got := (timespan.WindowSet{}).Uncovered(inverted); len(got) != 0 {
+		t.Errorf("Uncovered(inverted) = %v, want none", got)
+	}
+}
@@ -0,0 +1,36 @@
+package timespan
+
+import "time"
+
+// spanFunc returns the bounds of the period containing t.
+type spanFunc func(t time.Time) (start, end time.Time)
+
+// shiftSpan moves ref by delta periods of a calendar whose periods vary in
+// length. The day offset from the period start is kept and clamped to the
+// target period, unless toEnd asks for the target period's last day.
+func shiftSpan(bounds spanFunc, ref time.Time, delta int, toEnd bool) time.Time {
+	start, end := bounds(ref)
+	offset := daysBetween(start, ref)
+
+	for ; delta > 0; delta-- {
+		start, end = bounds(end.AddDate(0, 0, 1))
+	}
+	for ; delta < 0; delta++ {
+		start, end = bounds(start.AddDate(0, 0, -1))
+	}
+
+	if toEnd {
+		return end
+	}
+
+	t := start.AddDate(0, 0, offset)
+	if t.After(end) {
+		return end
+	}
+	return t
+}
+
+func isSpanEnd(bounds spanFunc, t time.Time) bool {
+	_, end := bounds(t)
+	return truncateToDay(t).Equal(end)
+}
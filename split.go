@@ -0,0 +1,83 @@
+package timespan
+
+import (
+	"iter"
+	"time"
+)
+
+type splitConfig struct {
+	whole  bool
+	anchor Anchor
+}
+
+// SplitOption configures Split.
+type SplitOption func(*splitConfig)
+
+// SplitWhole keeps the periods at the edges of the window whole instead of
+// clipping them to the window.
+func SplitWhole() SplitOption {
+	return func(c *splitConfig) { c.whole = true }
+}
+
+// SplitAnchor sets the anchor of the produced windows. The default is
+// StartAnchor.
+func SplitAnchor(a Anchor) SplitOption {
+	return func(c *splitConfig) { c.anchor = a }
+}
+
+// Split yields the windows of period p that cover w, in order, such as the
+// months of a quarter or the half months of a custom range. p must be a
+// registered period.
+//
+// Edge periods that extend beyond w are clipped to it unless SplitWhole is
+// given. A clipped window keeps the anchor on its cut edge, since that is
+// the only one it can be anchored on, and a period cut on both edges, when
+// w lies inside a single period, is yielded as a CustomWindow.
+func Split(w Window, p Period, opts ...SplitOption) (iter.Seq[Window], error) {
+	c, err := lookupPeriod(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg splitConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if !cfg.anchor.Valid() {
+		return nil, &ValidationError{Period: p, Start: w.Start(), End: w.End(), Err: ErrInvalidAnchor}
+	}
+
+	first, last := truncateToDay(w.Start()), truncateToDay(w.End())
+
+	return func(yield func(Window) bool) {
+		for day := first; !day.After(last); {
+			start, end := c.endingOn(day).Start(), c.startingOn(day).End()
+			if !yield(splitPiece(c, cfg, start, end, first, last)) {
+				return
+			}
+			day = end.AddDate(0, 0, 1)
+		}
+	}, nil
+}
+
+// splitPiece returns the window for the period from start to end, clipped
+// to first and last when cfg asks for it.
+func splitPiece(c periodConstructors, cfg splitConfig, start, end, first, last time.Time) Window {
+	if !cfg.whole {
+		cutStart, cutEnd := start.Before(first), end.After(last)
+
+		switch {
+		case cutStart && cutEnd:
+			return NewCustomWindow(first, last)
+		case cutStart:
+			return c.startingOn(first)
+		case cutEnd:
+			return c.endingOn(last)
+		}
+	}
+
+	if cfg.anchor == EndAnchor {
+		return c.endingOn(end)
+	}
+	return c.startingOn(start)
+}
@@ -0,0 +1,172 @@
+package timespan_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name       string
+		window     timespan.Window
+		period     timespan.Period
+		opts       []timespan.SplitOption
+		want       []span
+		wantPeriod []timespan.Period
+	}{
+		{
+			name:   "year into quarters",
+			window: timespan.NewYearWindowStartingOn(mustDate(t, "2026-01-01")),
+			period: timespan.Quarter,
+			want: []span{
+				{"2026-01-01", "2026-03-31"},
+				{"2026-04-01", "2026-06-30"},
+				{"2026-07-01", "2026-09-30"},
+				{"2026-10-01", "2026-12-31"},
+			},
+		},
+		{
+			name:   "quarter into months",
+			window: timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-01-01")),
+			period: timespan.Month,
+			want: []span{
+				{"2026-01-01", "2026-01-31"},
+				{"2026-02-01", "2026-02-28"},
+				{"2026-03-01", "2026-03-31"},
+			},
+		},
+		{
+			name:   "month into ISO weeks clipped",
+			window: timespan.NewMonthWindowStartingOn(mustDate(t, "2026-02-01")),
+			period: timespan.ISOWeek,
+			want: []span{
+				{"2026-02-01", "2026-02-01"},
+				{"2026-02-02", "2026-02-08"},
+				{"2026-02-09", "2026-02-15"},
+				{"2026-02-16", "2026-02-22"},
+				{"2026-02-23", "2026-02-28"},
+			},
+		},
+		{
+			name:   "month into ISO weeks whole",
+			window: timespan.NewMonthWindowStartingOn(mustDate(t, "2026-02-01")),
+			period: timespan.ISOWeek,
+			opts:   []timespan.SplitOption{timespan.SplitWhole()},
+			want: []span{
+				{"2026-01-26", "2026-02-01"},
+				{"2026-02-02", "2026-02-08"},
+				{"2026-02-09", "2026-02-15"},
+				{"2026-02-16", "2026-02-22"},
+				{"2026-02-23", "2026-03-01"},
+			},
+		},
+		{
+			name:   "custom into half months",
+			window: timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-02-20")),
+			period: timespan.HalfMonth,
+			want: []span{
+				{"2026-01-10", "2026-01-15"},
+				{"2026-01-16", "2026-01-31"},
+				{"2026-02-01", "2026-02-15"},
+				{"2026-02-16", "2026-02-20"},
+			},
+		},
+		{
+			name:   "custom inside a single period",
+			window: timespan.NewCustomWindow(mustDate(t, "2026-01-10"), mustDate(t, "2026-01-20")),
+			period: timespan.Month,
+			want:   []span{{"2026-01-10", "2026-01-20"}},
+			wantPeriod: []timespan.Period{
+				timespan.Custom,
+			},
+		},
+		{
+			name:   "custom into days",
+			window: timespan.NewCustomWindow(mustDate(t, "2026-01-30"), mustDate(t, "2026-02-01")),
+			period: timespan.Day,
+			want: []span{
+				{"2026-01-30", "2026-01-30"},
+				{"2026-01-31", "2026-01-31"},
+				{"2026-02-01", "2026-02-01"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq, err := timespan.Split(tt.window, tt.period, tt.opts...)
+			if err != nil {
+				t.Fatalf("Split() error = %v", err)
+			}
+
+			got := slices.Collect(seq)
+			assertSpans(t, got, tt.want)
+
+			for i, w := range got {
+				want := tt.period
+				if tt.wantPeriod != nil {
+					want = tt.wantPeriod[i]
+				}
+				if p := timespan.PeriodOf(w); p != want {
+					t.Errorf("window %d period = %q, want %q", i, p, want)
+				}
+				if want != timespan.Custom {
+					if err := timespan.Validate(w); err != nil {
+						t.Errorf("window %d Validate() = %v", i, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSplit_Anchor(t *testing.T) {
+	q1 := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	seq, err := timespan.Split(q1, timespan.Month, timespan.SplitAnchor(timespan.EndAnchor))
+	if err != nil {
+		t.Fatalf("Split() error = %v", err)
+	}
+
+	months := slices.Collect(seq)
+	if len(months) != 3 {
+		t.Fatalf("got %d months, want 3", len(months))
+	}
+
+	// End-anchored months keep ending on the last day when stepped.
+	assertWindow(t, months[1].Next(), mustDate(t, "2026-03-01"), mustDate(t, "2026-03-31"))
+}
+
+func TestSplit_Errors(t *testing.T) {
+	q1 := timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	if _, err := timespan.Split(q1, "decade"); !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("Split(decade) error = %v, want ErrUnknownPeriod", err)
+	}
+	if _, err := timespan.Split(q1, timespan.Month, timespan.SplitAnchor(5)); !errors.Is(err, timespan.ErrInvalidAnchor) {
+		t.Errorf("Split(anchor 5) error = %v, want ErrInvalidAnchor", err)
+	}
+}
+
+func TestSplit_StopsEarly(t *testing.T) {
+	year := timespan.NewYearWindowStartingOn(mustDate(t, "2026-01-01"))
+
+	seq, err := timespan.Split(year, timespan.Day)
+	if err != nil {
+		t.Fatalf("Split() error = %v", err)
+	}
+
+	n := 0
+	for range seq {
+		n++
+		if n == 10 {
+			break
+		}
+	}
+	if n != 10 {
+		t.Errorf("iterated %d days, want 10", n)
+	}
+}
@@ -0,0 +1,202 @@
+package timespan
+
+import "time"
+
+const (
+	AccountingPeriod Period = "accountingperiod"
+	AccountingYear   Period = "accountingyear"
+)
+
+// ThirteenPeriodCalendar splits a 52/53-week year into thirteen periods of
+// four weeks. Period 13 takes the extra week of a 53-week year.
+type ThirteenPeriodCalendar struct {
+	YearEnd YearEnd
+}
+
+// period returns the bounds and the 1-based number of the accounting period
+// containing t.
+func (c ThirteenPeriodCalendar) period(t time.Time) (start, end time.Time, index int) {
+	yearStart, yearEnd := c.YearEnd.bounds(t)
+
+	index = daysBetween(yearStart, t)/28 + 1
+	if index > 13 {
+		index = 13
+	}
+
+	start = yearStart.AddDate(0, 0, 28*(index-1))
+	end = start.AddDate(0, 0, 27)
+	if index == 13 {
+		end = yearEnd
+	}
+
+	return start, end, index
+}
+
+// index returns the 1-based position within its fiscal year of the period
+// p containing t.
+func (c ThirteenPeriodCalendar) index(p Period, t time.Time) int {
+	if p == AccountingYear {
+		return 1
+	}
+
+	_, _, index := c.period(t)
+	return index
+}
+
+func (c ThirteenPeriodCalendar) bounds(p Period, t time.Time) (start, end time.Time) {
+	if p == AccountingYear {
+		return c.YearEnd.bounds(t)
+	}
+
+	start, end, _ = c.period(t)
+	return start, end
+}
+
+// ThirteenPeriodWindow is an accounting period or year of a
+// ThirteenPeriodCalendar.
+type ThirteenPeriodWindow struct {
+	start           time.Time
+	end             time.Time
+	anchor          Anchor
+	shouldBeLastDay bool
+	period          Period
+	calendar        ThirteenPeriodCalendar
+}
+
+func (w *ThirteenPeriodWindow) Index() int {
+	return w.calendar.index(w.period, w.end)
+}
+
+func (w *ThirteenPeriodWindow) FiscalYear() int {
+	return w.calendar.YearEnd.FiscalYear(w.end)
+}
+
+func (w *ThirteenPeriodWindow) Period() Period                   { return w.period }
+func (w *ThirteenPeriodWindow) Calendar() ThirteenPeriodCalendar { return w.calendar }
+
+func (w *ThirteenPeriodWindow) Validate() error {
+	return validateSpan(w.period, w.anchor, w.start, w.end, w.spanFunc())
+}
+
+func (w *ThirteenPeriodWindow) Start() time.Time { return w.start }
+func (w *ThirteenPeriodWindow) SetStart(t time.Time) {
+	w.start = t
+	if w.anchor == StartAnchor {
+		w.shouldBeLastDay = isSpanEnd(w.spanFunc(), t)
+	}
+}
+
+func (w *ThirteenPeriodWindow) End() time.Time { return w.end }
+func (w *ThirteenPeriodWindow) SetEnd(t time.Time) {
+	w.end = t
+	if w.anchor == EndAnchor {
+		w.shouldBeLastDay = isSpanEnd(w.spanFunc(), t)
+	}
+}
+
+func (w *ThirteenPeriodWindow) WithStart(t time.Time) Window {
+	c := *w
+	c.SetStart(t)
+	return &c
+}
+
+func (w *ThirteenPeriodWindow) WithEnd(t time.Time) Window {
+	c := *w
+	c.SetEnd(t)
+	return &c
+}
+
+func (w *ThirteenPeriodWindow) Next(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return w.shift(thirteenPeriodsPerYear(w.period))
+	}
+
+	return w.shift(1)
+}
+
+func (w *ThirteenPeriodWindow) Prev(s ...Step) Window {
+	if step, ok := GetFirst(s); ok && step == StepYear {
+		return w.shift(-thirteenPeriodsPerYear(w.period))
+	}
+
+	return w.shift(-1)
+}
+
+func (w *ThirteenPeriodWindow) Complete() Window {
+	ref := w.end
+	if w.anchor == StartAnchor {
+		ref = w.start
+	}
+
+	start, end := w.calendar.bounds(w.period, ref)
+
+	return &ThirteenPeriodWindow{
+		start:           start,
+		end:             end,
+		anchor:          w.anchor,
+		shouldBeLastDay: true,
+		period:          w.period,
+		calendar:        w.calendar,
+	}
+}
+
+func (w *ThirteenPeriodWindow) shift(delta int) Window {
+	ref := w.end
+	if w.anchor == StartAnchor {
+		ref = w.start
+	}
+
+	ref = shiftSpan(w.spanFunc(), ref, delta, w.shouldBeLastDay)
+
+	return newThirteenPeriodWindow(w.calendar, w.period, w.anchor, ref)
+}
+
+func (w *ThirteenPeriodWindow) spanFunc() spanFunc {
+	return func(t time.Time) (time.Time, time.Time) {
+		return w.calendar.bounds(w.period, t)
+	}
+}
+
+func NewAccountingPeriodWindowStartingOn(c ThirteenPeriodCalendar, t time.Time) Window {
+	return newThirteenPeriodWindow(c, AccountingPeriod, StartAnchor, t)
+}
+
+func NewAccountingPeriodWindowEndingOn(c ThirteenPeriodCalendar, t time.Time) Window {
+	return newThirteenPeriodWindow(c, AccountingPeriod, EndAnchor, t)
+}
+
+func NewAccountingYearWindowStartingOn(c ThirteenPeriodCalendar, t time.Time) Window {
+	return newThirteenPeriodWindow(c, AccountingYear, StartAnchor, t)
+}
+
+func NewAccountingYearWindowEndingOn(c ThirteenPeriodCalendar, t time.Time) Window {
+	return newThirteenPeriodWindow(c, AccountingYear, EndAnchor, t)
+}
+
+func newThirteenPeriodWindow(c ThirteenPeriodCalendar, p Period, a Anchor, t time.Time) Window {
+	start, end := c.bounds(p, t)
+
+	w := &ThirteenPeriodWindow{
+		start:           start,
+		end:             end,
+		anchor:          a,
+		shouldBeLastDay: truncateToDay(t).Equal(end),
+		period:          p,
+		calendar:        c,
+	}
+
+	if a == StartAnchor {
+		w.start = truncateToDay(t)
+	} else {
+		w.end = truncateToDay(t)
+	}
+
+	return w
+}
+
+func thirteenPeriodsPerYear(p Period) int {
+	if p == AccountingYear {
+		return 1
+	}
+	return 13
+}
@@ -0,0 +1,111 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+var thirteen = timespan.ThirteenPeriodCalendar{
+	YearEnd: timespan.YearEnd{Month: time.December, Weekday: time.Saturday},
+}
+
+func TestThirteenPeriodWindow_Complete(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func(timespan.ThirteenPeriodCalendar, time.Time) timespan.Window
+		input     string
+		wantStart string
+		wantEnd   string
+		wantIndex int
+	}{
+		{"first period", timespan.NewAccountingPeriodWindowEndingOn, "2026-01-10", "2025-12-28", "2026-01-24", 1},
+		{"second period", timespan.NewAccountingPeriodWindowStartingOn, "2026-02-01", "2026-01-25", "2026-02-21", 2},
+		{"thirteenth period", timespan.NewAccountingPeriodWindowEndingOn, "2026-12-26", "2026-11-29", "2026-12-26", 13},
+		{"thirteenth period with fifth week", timespan.NewAccountingPeriodWindowEndingOn, "2022-12-30", "2022-11-27", "2022-12-31", 13},
+		{"year", timespan.NewAccountingYearWindowEndingOn, "2026-06-01", "2025-12-28", "2026-12-26", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(thirteen, mustDate(t, tt.input)).Complete()
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+
+			if idx := got.Index(); idx != tt.wantIndex {
+				t.Errorf("index = %d, want %d", idx, tt.wantIndex)
+			}
+		})
+	}
+}
+
+func TestThirteenPeriodWindow_NextPrev(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         timespan.Window
+		next      bool
+		step      []timespan.Step
+		wantStart string
+		wantEnd   string
+		wantIndex int
+	}{
+		{
+			name:      "period to date keeps day offset",
+			w:         timespan.NewAccountingPeriodWindowEndingOn(thirteen, mustDate(t, "2026-01-10")),
+			next:      true,
+			wantStart: "2026-01-25",
+			wantEnd:   "2026-02-07",
+			wantIndex: 2,
+		},
+		{
+			name:      "long period 13 rolls into period 1",
+			w:         timespan.NewAccountingPeriodWindowEndingOn(thirteen, mustDate(t, "2022-12-31")),
+			next:      true,
+			wantStart: "2023-01-01",
+			wantEnd:   "2023-01-28",
+			wantIndex: 1,
+		},
+		{
+			name:      "period 1 back to long period 13",
+			w:         timespan.NewAccountingPeriodWindowEndingOn(thirteen, mustDate(t, "2023-01-28")),
+			wantStart: "2022-11-27",
+			wantEnd:   "2022-12-31",
+			wantIndex: 13,
+		},
+		{
+			name:      "year step keeps period",
+			w:         timespan.NewAccountingPeriodWindowStartingOn(thirteen, mustDate(t, "2026-02-22")),
+			next:      true,
+			step:      []timespan.Step{timespan.StepYear},
+			wantStart: "2027-02-21",
+			wantEnd:   "2027-03-20",
+			wantIndex: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.w.Prev(tt.step...)
+			if tt.next {
+				got = tt.w.Next(tt.step...)
+			}
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+
+			if idx := got.Index(); idx != tt.wantIndex {
+				t.Errorf("index = %d, want %d", idx, tt.wantIndex)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package timespan
 
 import (
+	"errors"
+	"fmt"
 	"iter"
 	"time"
 )
@@ -31,24 +33,39 @@ func (p Step) Valid() bool {
 type Period string
 
 const (
-	Custom    Period = "custom"
-	Week      Period = "week"
-	HalfMonth Period = "halfmonth"
-	Month     Period = "month"
-	Quarter   Period = "quarter"
-	Semester  Period = "semester"
-	Year      Period = "year"
+	Custom       Period = "custom"
+	Day          Period = "day"
+	Week         Period = "week"
+	ISOWeek      Period = "isoweek"
+	SundayWeek   Period = "sundayweek"
+	MondayWeek   Period = "mondayweek"
+	SaturdayWeek Period = "saturdayweek"
+	CalendarWeek Period = "calendarweek"
+	HalfMonth    Period = "halfmonth"
+	Month        Period = "month"
+	Quarter      Period = "quarter"
+	Semester     Period = "semester"
+	Year         Period = "year"
 )
 
 func (p Period) Valid() bool {
-	switch p {
-	case Week, HalfMonth, Month, Quarter, Semester, Year:
-		return true
-	default:
-		return false
-	}
+	return isRegistered(p)
 }
 
+// Window is a span of whole days. Windows behave as values: Next, Prev and
+// Complete return new windows and never modify the receiver, so a window may
+// be shared between goroutines. Windows that implement Copier, as every
+// window of this package does, return a copy with a new edge from WithStart
+// and WithEnd.
+//
+// SetStart and SetEnd are kept for compatibility. They modify the window in
+// place and must not be called on a window that is shared.
+//
+// Index is the 1-based position of the period containing End within its
+// year: the calendar year, or the ISO, week-based, fiscal, retail or
+// broadcast year the window's calendar counts in. A quarter is 1 to 4, a
+// half month 1 to 24 and a week of the month 1 to 48. Windows that span a
+// whole year, and custom windows, are always 1. WindowAt is the inverse.
 type Window interface {
 	SetStart(t time.Time)
 	Start() time.Time
@@ -60,12 +77,63 @@ type Window interface {
 	Index() int
 }
 
-func WindowEndingOn(period Period, t time.Time) Window {
-	return nil
+// ErrNotCopier is returned by WithStart and WithEnd for windows that do not
+// implement Copier.
+var ErrNotCopier = errors.New("timespan: window does not implement Copier")
+
+// Copier is implemented by windows that can return a copy of themselves
+// with a new start or end. Every window in this package implements it.
+type Copier interface {
+	WithStart(t time.Time) Window
+	WithEnd(t time.Time) Window
 }
 
-func WindowStartingOn(period Period, t time.Time) Window {
-	return nil
+// WithStart returns a copy of w that starts on t, leaving w unchanged. Only
+// the window can copy itself safely, so windows that do not implement
+// Copier are reported with ErrNotCopier rather than copied.
+func WithStart(w Window, t time.Time) (Window, error) {
+	c, ok := w.(Copier)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotCopier, w)
+	}
+	return c.WithStart(t), nil
+}
+
+// WithEnd returns a copy of w that ends on t, leaving w unchanged, or
+// ErrNotCopier as WithStart does.
+func WithEnd(w Window, t time.Time) (Window, error) {
+	c, ok := w.(Copier)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotCopier, w)
+	}
+	return c.WithEnd(t), nil
+}
+
+func WindowEndingOn(period Period, t time.Time) (Window, error) {
+	c, err := lookupPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.endingOn(t), nil
+}
+
+func WindowStartingOn(period Period, t time.Time) (Window, error) {
+	c, err := lookupPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.startingOn(t), nil
+}
+
+// PeriodOf returns the period of w, or the empty Period when w does not
+// report one.
+func PeriodOf(w Window) Period {
+	if p, ok := w.(interface{ Period() Period }); ok {
+		return p.Period()
+	}
+	return ""
 }
 
 func Days(w Window) iter.Seq[time.Time] {
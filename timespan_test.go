@@ -0,0 +1,203 @@
+package timespan_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestWindow_WithStartWithEnd(t *testing.T) {
+	tests := []struct {
+		name      string
+		w         timespan.Window
+		start     string
+		end       string
+		wantStart string
+		wantEnd   string
+		wantNext  [2]string
+	}{
+		{
+			name:      "month keeps original",
+			w:         timespan.NewMonthWindowEndingOn(mustDate(t, "2026-01-15")),
+			end:       "2026-01-31",
+			wantStart: "2026-01-01",
+			wantEnd:   "2026-01-31",
+			wantNext:  [2]string{"2026-02-01", "2026-02-28"},
+		},
+		{
+			name:      "quarter",
+			w:         timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-04-01")),
+			start:     "2026-05-01",
+			wantStart: "2026-05-01",
+			wantEnd:   "2026-06-30",
+			wantNext:  [2]string{"2026-08-01", "2026-09-30"},
+		},
+		{
+			name:      "custom recomputes duration",
+			w:         timespan.NewCustomWindow(mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10")),
+			end:       "2026-01-05",
+			wantStart: "2026-01-01",
+			wantEnd:   "2026-01-05",
+			wantNext:  [2]string{"2026-01-05", "2026-01-09"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origStart, origEnd := tt.w.Start(), tt.w.End()
+
+			got := tt.w
+			if tt.start != "" {
+				got = mustWithStart(t, got, mustDate(t, tt.start))
+			}
+			if tt.end != "" {
+				got = mustWithEnd(t, got, mustDate(t, tt.end))
+			}
+
+			assertWindow(t, got, mustDate(t, tt.wantStart), mustDate(t, tt.wantEnd))
+			assertWindow(t, tt.w, origStart, origEnd)
+			assertWindow(t, got.Next(), mustDate(t, tt.wantNext[0]), mustDate(t, tt.wantNext[1]))
+		})
+	}
+}
+
+// plainWindow implements Window without Copier, as windows defined outside
+// the package may.
+type plainWindow struct {
+	start, end time.Time
+}
+
+func (w *plainWindow) SetStart(t time.Time) { w.start = t }
+func (w *plainWindow) Start() time.Time     { return w.start }
+func (w *plainWindow) SetEnd(t time.Time)   { w.end = t }
+func (w *plainWindow) End() time.Time       { return w.end }
+func (w *plainWindow) Complete() timespan.Window {
+	return &plainWindow{w.start, w.end}
+}
+func (w *plainWindow) Next(...timespan.Step) timespan.Window { return w }
+func (w *plainWindow) Prev(...timespan.Step) timespan.Window { return w }
+func (w *plainWindow) Index() int                            { return 1 }
+
+func TestWithStartWithEnd_WithoutCopier(t *testing.T) {
+	w := &plainWindow{mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10")}
+
+	if got, err := timespan.WithStart(w, mustDate(t, "2026-01-03")); !errors.Is(err, timespan.ErrNotCopier) || got != nil {
+		t.Errorf("WithStart() = %v, %v, want ErrNotCopier", got, err)
+	}
+	if got, err := timespan.WithEnd(w, mustDate(t, "2026-01-05")); !errors.Is(err, timespan.ErrNotCopier) || got != nil {
+		t.Errorf("WithEnd() = %v, %v, want ErrNotCopier", got, err)
+	}
+	assertWindow(t, w, mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10"))
+}
+
+// mustWithStart returns w moved to start on start.
+func mustWithStart(t *testing.T, w timespan.Window, start time.Time) timespan.Window {
+	t.Helper()
+
+	got, err := timespan.WithStart(w, start)
+	if err != nil {
+		t.Fatalf("WithStart(): %v", err)
+	}
+	return got
+}
+
+// mustWithEnd returns w moved to end on end.
+func mustWithEnd(t *testing.T, w timespan.Window, end time.Time) timespan.Window {
+	t.Helper()
+
+	got, err := timespan.WithEnd(w, end)
+	if err != nil {
+		t.Fatalf("WithEnd(): %v", err)
+	}
+	return got
+}
+
+func TestWindow_CompleteDoesNotAlias(t *testing.T) {
+	w := timespan.NewCustomWindow(mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10"))
+
+	c := w.Complete()
+	c.SetEnd(mustDate(t, "2026-02-01"))
+
+	assertWindow(t, w, mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10"))
+}
+
+func TestWindow_ConcurrentUse(t *testing.T) {
+	shared := []timespan.Window{
+		timespan.NewMonthWindowEndingOn(mustDate(t, "2026-01-31")),
+		timespan.NewHalfMonthWindowStartingOn(mustDate(t, "2026-01-20")),
+		timespan.NewWeekWindowEndingOn(mustDate(t, "2026-01-12")),
+		timespan.NewQuarterWindowEndingOn(mustDate(t, "2026-02-10")),
+		timespan.NewSemesterWindowStartingOn(mustDate(t, "2026-02-10")),
+		timespan.NewYearWindowEndingOn(mustDate(t, "2026-02-10")),
+		timespan.NewISOWeekWindowEndingOn(mustDate(t, "2026-02-10")),
+		timespan.NewCalendarWeekWindowEndingOn(mustDate(t, "2026-02-10"), time.Sunday),
+		timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-02-10")),
+		timespan.NewRetailMonthWindowEndingOn(nrf, mustDate(t, "2026-02-10")),
+		timespan.NewWeekYearWindowEndingOn(nrfYearEnd, mustDate(t, "2026-02-10")),
+		timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-02-10")),
+		timespan.NewAccountingPeriodWindowEndingOn(thirteen, mustDate(t, "2026-02-10")),
+		timespan.NewCustomWindow(mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10")),
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range shared {
+		start, end := w.Start(), w.End()
+
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for range 50 {
+					w.Next().Prev(timespan.StepYear).Complete()
+					c := w.(timespan.Copier)
+					c.WithStart(w.Start().AddDate(0, 0, 1))
+					c.WithEnd(w.End())
+					w.Index()
+					_ = timespan.Validate(w)
+					for range timespan.Days(w) {
+					}
+				}
+			}()
+		}
+
+		t.Cleanup(func() {
+			assertWindow(t, w, start, end)
+		})
+	}
+	wg.Wait()
+}
+
+func TestWindow_Index(t *testing.T) {
+	tests := []struct {
+		name   string
+		window timespan.Window
+		want   int
+	}{
+		{"year", timespan.NewYearWindowStartingOn(mustDate(t, "2026-05-10")), 1},
+		{"first semester", timespan.NewSemesterWindowEndingOn(mustDate(t, "2026-01-10")), 1},
+		{"second semester", timespan.NewSemesterWindowStartingOn(mustDate(t, "2026-07-01")), 2},
+		{"first quarter", timespan.NewQuarterWindowEndingOn(mustDate(t, "2026-01-10")), 1},
+		{"quarter ending in March", timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-02-10")), 1},
+		{"fourth quarter", timespan.NewQuarterWindowStartingOn(mustDate(t, "2026-10-01")), 4},
+		{"month", timespan.NewMonthWindowStartingOn(mustDate(t, "2026-05-10")), 5},
+		{"first half of January", timespan.NewHalfMonthWindowStartingOn(mustDate(t, "2026-01-01")), 1},
+		{"second half of December", timespan.NewHalfMonthWindowStartingOn(mustDate(t, "2026-12-16")), 24},
+		{"first week of February", timespan.NewWeekWindowStartingOn(mustDate(t, "2026-02-01")), 5},
+		{"last week of December", timespan.NewWeekWindowStartingOn(mustDate(t, "2026-12-22")), 48},
+		{"day", timespan.NewDayWindowStartingOn(mustDate(t, "2026-02-03")), 34},
+		{"ISO week", timespan.NewISOWeekWindowStartingOn(mustDate(t, "2026-03-09")), 11},
+		{"custom", timespan.NewCustomWindow(mustDate(t, "2026-03-01"), mustDate(t, "2026-09-01")), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Index(); got != tt.want {
+				t.Errorf("Index() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
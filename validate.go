@@ -0,0 +1,184 @@
+package timespan
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrEndBeforeStart = errors.New("timespan: end before start")
+	ErrMisaligned     = errors.New("timespan: window not aligned to its period")
+	ErrInvalidAnchor  = errors.New("timespan: invalid anchor")
+)
+
+// ValidationError reports why a window is inconsistent. Err is one of
+// ErrEndBeforeStart, ErrMisaligned, ErrInvalidAnchor or ErrUnknownPeriod.
+type ValidationError struct {
+	Period Period
+	Start  time.Time
+	End    time.Time
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v: %s window %s - %s",
+		e.Err, e.Period, e.Start.Format(time.DateOnly), e.End.Format(time.DateOnly))
+}
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+type Validator interface {
+	Validate() error
+}
+
+// Validate reports whether w is consistent. Windows that do not implement
+// Validator are only checked for an end before their start.
+func Validate(w Window) error {
+	if v, ok := w.(Validator); ok {
+		return v.Validate()
+	}
+
+	if w.End().Before(w.Start()) {
+		return &ValidationError{Period: PeriodOf(w), Start: w.Start(), End: w.End(), Err: ErrEndBeforeStart}
+	}
+	return nil
+}
+
+func (a Anchor) Valid() bool {
+	return a == StartAnchor || a == EndAnchor
+}
+
+// NewWindow builds the window of period p from an untrusted start and end,
+// returning an error instead of a window that the constructors would not
+// produce. Custom accepts any start and end in order and ignores the anchor.
+func NewWindow(p Period, a Anchor, start, end time.Time) (Window, error) {
+	if p == Custom {
+		return newCustomWindow(start, end)
+	}
+
+	c, err := lookupPeriod(p)
+	if err != nil {
+		return nil, &ValidationError{Period: p, Start: start, End: end, Err: ErrUnknownPeriod}
+	}
+
+	return buildWindow(p, a, start, end, c.startingOn, c.endingOn)
+}
+
+func NewCalendarWeekWindow(firstDay time.Weekday, a Anchor, start, end time.Time) (Window, error) {
+	return buildWindow(calendarWeekPeriod(firstDay), a, start, end,
+		func(t time.Time) Window { return NewCalendarWeekWindowStartingOn(t, firstDay) },
+		func(t time.Time) Window { return NewCalendarWeekWindowEndingOn(t, firstDay) },
+	)
+}
+
+func NewFiscalWindow(c FiscalCalendar, p Period, a Anchor, start, end time.Time) (Window, error) {
+	switch p {
+	case FiscalMonth, FiscalQuarter, FiscalSemester, FiscalYear:
+	default:
+		return nil, &ValidationError{Period: p, Start: start, End: end, Err: ErrUnknownPeriod}
+	}
+
+	return buildWindow(p, a, start, end,
+		func(t time.Time) Window { return newFiscalWindow(c, p, StartAnchor, t) },
+		func(t time.Time) Window { return newFiscalWindow(c, p, EndAnchor, t) },
+	)
+}
+
+func NewRetailWindow(c RetailCalendar, p Period, a Anchor, start, end time.Time) (Window, error) {
+	switch p {
+	case RetailMonth, RetailQuarter, RetailYear:
+	default:
+		return nil, &ValidationError{Period: p, Start: start, End: end, Err: ErrUnknownPeriod}
+	}
+
+	return buildWindow(p, a, start, end,
+		func(t time.Time) Window { return newRetailWindow(c, p, StartAnchor, t) },
+		func(t time.Time) Window { return newRetailWindow(c, p, EndAnchor, t) },
+	)
+}
+
+func NewWeekYearWindow(r YearEnd, a Anchor, start, end time.Time) (Window, error) {
+	return buildWindow(WeekYear, a, start, end,
+		func(t time.Time) Window { return NewWeekYearWindowStartingOn(r, t) },
+		func(t time.Time) Window { return NewWeekYearWindowEndingOn(r, t) },
+	)
+}
+
+func NewThirteenPeriodWindow(c ThirteenPeriodCalendar, p Period, a Anchor, start, end time.Time) (Window, error) {
+	switch p {
+	case AccountingPeriod, AccountingYear:
+	default:
+		return nil, &ValidationError{Period: p, Start: start, End: end, Err: ErrUnknownPeriod}
+	}
+
+	return buildWindow(p, a, start, end,
+		func(t time.Time) Window { return newThirteenPeriodWindow(c, p, StartAnchor, t) },
+		func(t time.Time) Window { return newThirteenPeriodWindow(c, p, EndAnchor, t) },
+	)
+}
+
+// buildWindow constructs the window from its anchored edge and checks that
+// the other edge is the one the constructor derived.
+func buildWindow(p Period, a Anchor, start, end time.Time, startingOn, endingOn Constructor) (Window, error) {
+	fail := func(err error) (Window, error) {
+		return nil, &ValidationError{Period: p, Start: start, End: end, Err: err}
+	}
+
+	switch {
+	case !a.Valid():
+		return fail(ErrInvalidAnchor)
+	case end.Before(start):
+		return fail(ErrEndBeforeStart)
+	}
+
+	var w Window
+	if a == StartAnchor {
+		w = startingOn(start)
+	} else {
+		w = endingOn(end)
+	}
+
+	if !w.Start().Equal(start) || !w.End().Equal(end) {
+		return fail(ErrMisaligned)
+	}
+	return w, nil
+}
+
+// validateSpan checks that the edge opposite to the anchor lies on the
+// boundary of the period containing the anchored edge.
+func validateSpan(p Period, a Anchor, start, end time.Time, bounds spanFunc) error {
+	fail := func(err error) error {
+		return &ValidationError{Period: p, Start: start, End: end, Err: err}
+	}
+
+	switch {
+	case !a.Valid():
+		return fail(ErrInvalidAnchor)
+	case end.Before(start):
+		return fail(ErrEndBeforeStart)
+	case !start.Equal(truncateToDay(start)) || !end.Equal(truncateToDay(end)):
+		return fail(ErrMisaligned)
+	}
+
+	ref := end
+	if a == StartAnchor {
+		ref = start
+	}
+
+	first, last := bounds(ref)
+	if start.Before(first) || end.After(last) {
+		return fail(ErrMisaligned)
+	}
+	if (a == StartAnchor && !end.Equal(last)) || (a == EndAnchor && !start.Equal(first)) {
+		return fail(ErrMisaligned)
+	}
+
+	return nil
+}
+
+func spanOf(start, end func(time.Time) time.Time) spanFunc {
+	return func(t time.Time) (time.Time, time.Time) {
+		return start(t), end(t)
+	}
+}
@@ -0,0 +1,156 @@
+package timespan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+func TestNewWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		period  timespan.Period
+		anchor  timespan.Anchor
+		start   string
+		end     string
+		wantErr error
+	}{
+		{"month to date", timespan.Month, timespan.EndAnchor, "2026-03-01", "2026-03-12", nil},
+		{"month from date", timespan.Month, timespan.StartAnchor, "2026-03-12", "2026-03-31", nil},
+		{"complete quarter", timespan.Quarter, timespan.EndAnchor, "2026-04-01", "2026-06-30", nil},
+		{"custom", timespan.Custom, timespan.StartAnchor, "2026-03-12", "2026-05-02", nil},
+		{"end before start", timespan.Month, timespan.EndAnchor, "2026-03-12", "2026-03-01", timespan.ErrEndBeforeStart},
+		{"custom end before start", timespan.Custom, timespan.EndAnchor, "2026-03-12", "2026-03-01", timespan.ErrEndBeforeStart},
+		{"month start not first", timespan.Month, timespan.EndAnchor, "2026-03-02", "2026-03-12", timespan.ErrMisaligned},
+		{"month spanning two months", timespan.Month, timespan.StartAnchor, "2026-03-12", "2026-04-30", timespan.ErrMisaligned},
+		{"quarter end not quarter end", timespan.Quarter, timespan.StartAnchor, "2026-04-10", "2026-05-31", timespan.ErrMisaligned},
+		{"unknown period", "decade", timespan.StartAnchor, "2026-01-01", "2026-12-31", timespan.ErrUnknownPeriod},
+		{"invalid anchor", timespan.Month, timespan.Anchor(7), "2026-03-01", "2026-03-12", timespan.ErrInvalidAnchor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := mustDate(t, tt.start)
+			end := mustDate(t, tt.end)
+
+			got, err := timespan.NewWindow(tt.period, tt.anchor, start, end)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				var verr *timespan.ValidationError
+				if !errors.As(err, &verr) {
+					t.Fatalf("err = %T, want *ValidationError", err)
+				}
+				if verr.Period != tt.period {
+					t.Errorf("error period = %q, want %q", verr.Period, tt.period)
+				}
+				return
+			}
+
+			assertWindow(t, got, start, end)
+			if err := timespan.Validate(got); err != nil {
+				t.Errorf("validate: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewWindow_TimeOfDayIsMisaligned(t *testing.T) {
+	start := mustDate(t, "2026-03-01")
+	end := mustDate(t, "2026-03-12").Add(13 * time.Hour)
+
+	_, err := timespan.NewWindow(timespan.Month, timespan.EndAnchor, start, end)
+	if !errors.Is(err, timespan.ErrMisaligned) {
+		t.Errorf("err = %v, want ErrMisaligned", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		w       timespan.Window
+		start   string
+		end     string
+		wantErr error
+	}{
+		{"valid month", timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-12")), "", "", nil},
+		{"end before start", timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-12")), "", "2026-02-12", timespan.ErrEndBeforeStart},
+		{"misaligned start", timespan.NewMonthWindowEndingOn(mustDate(t, "2026-03-12")), "2026-03-05", "", timespan.ErrMisaligned},
+		{"misaligned week", timespan.NewWeekWindowStartingOn(mustDate(t, "2026-03-09")), "", "2026-03-20", timespan.ErrMisaligned},
+		{"valid iso week", timespan.NewISOWeekWindowEndingOn(mustDate(t, "2026-03-12")), "", "", nil},
+		{"misaligned semester", timespan.NewSemesterWindowStartingOn(mustDate(t, "2026-03-12")), "", "2026-12-31", timespan.ErrMisaligned},
+		{"misaligned fiscal quarter", timespan.NewFiscalQuarterWindowEndingOn(fyApril, mustDate(t, "2026-05-12")), "2026-05-01", "", timespan.ErrMisaligned},
+		{"valid broadcast month", timespan.NewBroadcastMonthWindowEndingOn(mustDate(t, "2026-01-10")), "", "", nil},
+		{"custom end before start", timespan.NewCustomWindow(mustDate(t, "2026-03-01"), mustDate(t, "2026-03-12")), "", "2026-02-01", timespan.ErrEndBeforeStart},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.start != "" {
+				tt.w.SetStart(mustDate(t, tt.start))
+			}
+			if tt.end != "" {
+				tt.w.SetEnd(mustDate(t, tt.end))
+			}
+
+			if err := timespan.Validate(tt.w); !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewCalendarWindows_Errors(t *testing.T) {
+	_, err := timespan.NewFiscalWindow(fyApril, timespan.FiscalQuarter, timespan.EndAnchor, mustDate(t, "2026-04-01"), mustDate(t, "2026-05-12"))
+	if err != nil {
+		t.Errorf("fiscal quarter to date: %v", err)
+	}
+
+	_, err = timespan.NewFiscalWindow(fyApril, timespan.Quarter, timespan.EndAnchor, mustDate(t, "2026-04-01"), mustDate(t, "2026-05-12"))
+	if !errors.Is(err, timespan.ErrUnknownPeriod) {
+		t.Errorf("calendar period on fiscal calendar: err = %v, want ErrUnknownPeriod", err)
+	}
+
+	_, err = timespan.NewRetailWindow(nrf, timespan.RetailMonth, timespan.StartAnchor, mustDate(t, "2025-03-10"), mustDate(t, "2025-03-31"))
+	if !errors.Is(err, timespan.ErrMisaligned) {
+		t.Errorf("retail month ending on calendar month end: err = %v, want ErrMisaligned", err)
+	}
+
+	_, err = timespan.NewCalendarWeekWindow(time.Saturday, timespan.StartAnchor, mustDate(t, "2026-03-14"), mustDate(t, "2026-03-20"))
+	if err != nil {
+		t.Errorf("saturday week: %v", err)
+	}
+
+	_, err = timespan.NewWeekYearWindow(nrfYearEnd, timespan.EndAnchor, mustDate(t, "2025-02-02"), mustDate(t, "2025-06-10"))
+	if err != nil {
+		t.Errorf("week year to date: %v", err)
+	}
+
+	_, err = timespan.NewThirteenPeriodWindow(thirteen, timespan.AccountingPeriod, timespan.EndAnchor, mustDate(t, "2026-01-01"), mustDate(t, "2026-01-10"))
+	if !errors.Is(err, timespan.ErrMisaligned) {
+		t.Errorf("accounting period to date from calendar month start: err = %v, want ErrMisaligned", err)
+	}
+}
+
+func TestNewWindow_CustomEndBeforeStart(t *testing.T) {
+	w, err := timespan.NewWindow(timespan.Custom, timespan.StartAnchor, mustDate(t, "2026-03-12"), mustDate(t, "2026-03-01"))
+	if !errors.Is(err, timespan.ErrEndBeforeStart) {
+		t.Errorf("err = %v, want ErrEndBeforeStart", err)
+	}
+	if w != nil {
+		t.Errorf("window = %v, want nil", w)
+	}
+}
+
+func TestNewCustomWindow_PanicsOnEndBeforeStart(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewCustomWindow did not panic")
+		}
+	}()
+
+	timespan.NewCustomWindow(mustDate(t, "2026-03-12"), mustDate(t, "2026-03-01"))
+}
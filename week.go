@@ -10,7 +10,13 @@ type WeekWindow struct {
 }
 
 func (w *WeekWindow) Index() int {
-	return weekIndex(w.end)
+	return civilIndex(Week, w.end)
+}
+
+func (w *WeekWindow) Period() Period { return Week }
+
+func (w *WeekWindow) Validate() error {
+	return validateSpan(Week, w.anchor, w.start, w.end, spanOf(weekStart, weekEnd))
 }
 
 func (w *WeekWindow) Start() time.Time { return w.start }
@@ -28,6 +34,18 @@ func (w *WeekWindow) SetEnd(t time.Time) {
 	w.end = t
 }
 
+func (w *WeekWindow) WithStart(t time.Time) Window {
+	c := *w
+	c.SetStart(t)
+	return &c
+}
+
+func (w *WeekWindow) WithEnd(t time.Time) Window {
+	c := *w
+	c.SetEnd(t)
+	return &c
+}
+
 func (w *WeekWindow) Next(s ...Step) Window {
 	step, ok := GetFirst(s)
 	if ok {
@@ -0,0 +1,129 @@
+package timespan
+
+import "time"
+
+const WeekYear Period = "weekyear"
+
+// WeekYearWindow is a 52/53-week fiscal year ending on the weekday chosen by
+// its YearEnd rule.
+type WeekYearWindow struct {
+	start           time.Time
+	end             time.Time
+	anchor          Anchor
+	shouldBeLastDay bool
+	rule            YearEnd
+}
+
+func (w *WeekYearWindow) Index() int {
+	return 1
+}
+
+func (w *WeekYearWindow) FiscalYear() int {
+	return w.rule.FiscalYear(w.end)
+}
+
+func (w *WeekYearWindow) Weeks() int {
+	return w.rule.weeks(w.rule.yearOf(w.end), w.end.Location())
+}
+
+func (w *WeekYearWindow) Is53Week() bool {
+	return w.Weeks() == 53
+}
+
+func (w *WeekYearWindow) Rule() YearEnd  { return w.rule }
+func (w *WeekYearWindow) Period() Period { return WeekYear }
+
+func (w *WeekYearWindow) Validate() error {
+	return validateSpan(WeekYear, w.anchor, w.start, w.end, w.rule.bounds)
+}
+
+func (w *WeekYearWindow) Start() time.Time { return w.start }
+func (w *WeekYearWindow) SetStart(t time.Time) {
+	w.start = t
+	if w.anchor == StartAnchor {
+		w.shouldBeLastDay = isSpanEnd(w.rule.bounds, t)
+	}
+}
+
+func (w *WeekYearWindow) End() time.Time { return w.end }
+func (w *WeekYearWindow) SetEnd(t time.Time) {
+	w.end = t
+	if w.anchor == EndAnchor {
+		w.shouldBeLastDay = isSpanEnd(w.rule.bounds, t)
+	}
+}
+
+func (w *WeekYearWindow) WithStart(t time.Time) Window {
+	c := *w
+	c.SetStart(t)
+	return &c
+}
+
+func (w *WeekYearWindow) WithEnd(t time.Time) Window {
+	c := *w
+	c.SetEnd(t)
+	return &c
+}
+
+func (w *WeekYearWindow) Next(s ...Step) Window {
+	return w.shift(1)
+}
+
+func (w *WeekYearWindow) Prev(s ...Step) Window {
+	return w.shift(-1)
+}
+
+func (w *WeekYearWindow) Complete() Window {
+	ref := w.end
+	if w.anchor == StartAnchor {
+		ref = w.start
+	}
+
+	start, end := w.rule.bounds(ref)
+
+	return &WeekYearWindow{
+		start:           start,
+		end:             end,
+		anchor:          w.anchor,
+		shouldBeLastDay: true,
+		rule:            w.rule,
+	}
+}
+
+func (w *WeekYearWindow) shift(delta int) Window {
+	ref := w.end
+	if w.anchor == StartAnchor {
+		ref = w.start
+	}
+
+	ref = shiftSpan(w.rule.bounds, ref, delta, w.shouldBeLastDay)
+
+	if w.anchor == StartAnchor {
+		return NewWeekYearWindowStartingOn(w.rule, ref)
+	}
+	return NewWeekYearWindowEndingOn(w.rule, ref)
+}
+
+func NewWeekYearWindowStartingOn(r YearEnd, t time.Time) Window {
+	_, end := r.bounds(t)
+
+	return &WeekYearWindow{
+		start:           truncateToDay(t),
+		end:             end,
+		anchor:          StartAnchor,
+		shouldBeLastDay: isSpanEnd(r.bounds, t),
+		rule:            r,
+	}
+}
+
+func NewWeekYearWindowEndingOn(r YearEnd, t time.Time) Window {
+	start, _ := r.bounds(t)
+
+	return &WeekYearWindow{
+		start:           start,
+		end:             truncateToDay(t),
+		anchor:          EndAnchor,
+		shouldBeLastDay: isSpanEnd(r.bounds, t),
+		rule:            r,
+	}
+}
@@ -0,0 +1,101 @@
+package timespan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trillion-Digital/timespan"
+)
+
+var (
+	nrfYearEnd = timespan.YearEnd{
+		Month:   time.January,
+		Weekday: time.Saturday,
+		Method:  timespan.NearestWeekday,
+		Naming:  timespan.FiscalYearNamedByStartYear,
+	}
+	lastSaturdayOfJanuary = timespan.YearEnd{
+		Month:   time.January,
+		Weekday: time.Saturday,
+		Method:  timespan.LastWeekday,
+		Naming:  timespan.FiscalYearNamedByStartYear,
+	}
+)
+
+func TestWeekYearWindow_Complete(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      timespan.YearEnd
+		input     string
+		wantStart string
+		wantEnd   string
+		wantWeeks int
+		wantFY    int
+	}{
+		{"nearest 52 weeks", nrfYearEnd, "2025-06-10", "2025-02-02", "2026-01-31", 52, 2025},
+		{"nearest 53 weeks", nrfYearEnd, "2023-06-10", "2023-01-29", "2024-02-03", 53, 2023},
+		{"nearest in january", nrfYearEnd, "2024-01-15", "2023-01-29", "2024-02-03", 53, 2023},
+		{"last saturday 53 weeks", lastSaturdayOfJanuary, "2025-06-10", "2025-01-26", "2026-01-31", 53, 2025},
+		{"last saturday 52 weeks", lastSaturdayOfJanuary, "2026-06-10", "2026-02-01", "2027-01-30", 52, 2026},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timespan.NewWeekYearWindowEndingOn(tt.rule, mustDate(t, tt.input)).Complete()
+
+			assertWindow(
+				t,
+				got,
+				mustDate(t, tt.wantStart),
+				mustDate(t, tt.wantEnd),
+			)
+
+			w := got.(*timespan.WeekYearWindow)
+			if weeks := w.Weeks(); weeks != tt.wantWeeks {
+				t.Errorf("weeks = %d, want %d", weeks, tt.wantWeeks)
+			}
+			if w.Is53Week() != (tt.wantWeeks == 53) {
+				t.Errorf("is 53 week = %v", w.Is53Week())
+			}
+			if fy := w.FiscalYear(); fy != tt.wantFY {
+				t.Errorf("fiscal year = %d, want %d", fy, tt.wantFY)
+			}
+
+			var days int
+			for range timespan.Days(got) {
+				days++
+			}
+			if days != 7*tt.wantWeeks {
+				t.Errorf("days = %d, want %d", days, 7*tt.wantWeeks)
+			}
+		})
+	}
+}
+
+func TestYearEnd_Bounds(t *testing.T) {
+	start, end := nrfYearEnd.Bounds(2023, time.UTC)
+
+	if !start.Equal(mustDate(t, "2023-01-29")) || !end.Equal(mustDate(t, "2024-02-03")) {
+		t.Errorf("bounds = %v - %v", start, end)
+	}
+	if weeks := nrfYearEnd.Weeks(2023); weeks != 53 {
+		t.Errorf("weeks = %d, want 53", weeks)
+	}
+	if weeks := nrfYearEnd.Weeks(2024); weeks != 52 {
+		t.Errorf("weeks = %d, want 52", weeks)
+	}
+}
+
+func TestWeekYearWindow_NextPrev(t *testing.T) {
+	w := timespan.NewWeekYearWindowEndingOn(nrfYearEnd, mustDate(t, "2025-06-10"))
+
+	assertWindow(t, w.Next(), mustDate(t, "2026-02-01"), mustDate(t, "2026-06-09"))
+	assertWindow(t, w.Prev(), mustDate(t, "2024-02-04"), mustDate(t, "2024-06-11"))
+
+	full := timespan.NewWeekYearWindowEndingOn(nrfYearEnd, mustDate(t, "2024-02-03"))
+	assertWindow(t, full.Next(), mustDate(t, "2024-02-04"), mustDate(t, "2025-02-01"))
+
+	if !timespan.ContainsWindow(full.Complete(), timespan.NewMonthWindowEndingOn(mustDate(t, "2024-01-31")).Complete()) {
+		t.Errorf("53 week year does not contain january")
+	}
+}
@@ -12,6 +12,12 @@ func (y *YearWindow) Index() int {
 	return 1
 }
 
+func (y *YearWindow) Period() Period { return Year }
+
+func (y *YearWindow) Validate() error {
+	return validateSpan(Year, y.anchor, y.start, y.end, spanOf(yearStart, yearEnd))
+}
+
 func (y *YearWindow) Start() time.Time { return y.start }
 func (y *YearWindow) SetStart(t time.Time) {
 	y.start = t
@@ -21,6 +27,18 @@ func (y *YearWindow) SetEnd(t time.Time) {
 	y.end = t
 }
 
+func (y *YearWindow) WithStart(t time.Time) Window {
+	c := *y
+	c.SetStart(t)
+	return &c
+}
+
+func (y *YearWindow) WithEnd(t time.Time) Window {
+	c := *y
+	c.SetEnd(t)
+	return &c
+}
+
 func (y *YearWindow) Next(s ...Step) Window {
 	return y.shift(1)
 }
@@ -60,27 +78,25 @@ func (y *YearWindow) shift(delta int) Window {
 }
 
 func NewYearWindowStartingOn(t time.Time) Window {
-	y, _, _ := t.Date()
-	loc := t.Location()
-
-	end := time.Date(y, 12, 31, 0, 0, 0, 0, loc)
-
 	return &YearWindow{
 		start:  truncateToDay(t),
-		end:    end,
+		end:    yearEnd(t),
 		anchor: StartAnchor,
 	}
 }
 
 func NewYearWindowEndingOn(t time.Time) Window {
-	y, _, _ := t.Date()
-	loc := t.Location()
-
-	start := time.Date(y, 1, 1, 0, 0, 0, 0, loc)
-
 	return &YearWindow{
-		start:  start,
+		start:  yearStart(t),
 		end:    truncateToDay(t),
 		anchor: EndAnchor,
 	}
 }
+
+func yearStart(t time.Time) time.Time {
+	return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+}
+
+func yearEnd(t time.Time) time.Time {
+	return time.Date(t.Year(), 12, 31, 0, 0, 0, 0, t.Location())
+}
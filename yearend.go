@@ -0,0 +1,109 @@
+package timespan
+
+import "time"
+
+type YearEndMethod int
+
+const (
+	// LastWeekday ends the year on the last Weekday of Month.
+	LastWeekday YearEndMethod = 0
+	// NearestWeekday ends the year on the Weekday closest to the last day
+	// of Month, which may fall in the following month.
+	NearestWeekday YearEndMethod = 1
+)
+
+// YearEnd is the rule of a 52/53-week year, e.g. the Saturday nearest to
+// January 31 used by the NRF retail calendar. The zero Month is December.
+type YearEnd struct {
+	Month   time.Month       `json:"month"`
+	Weekday time.Weekday     `json:"weekday"`
+	Method  YearEndMethod    `json:"method"`
+	Naming  FiscalYearNaming `json:"naming"`
+}
+
+// valid reports whether the fields of r are in range.
+func (r YearEnd) valid() bool {
+	return r.Month >= 0 && r.Month <= time.December &&
+		r.Weekday >= time.Sunday && r.Weekday <= time.Saturday &&
+		(r.Method == LastWeekday || r.Method == NearestWeekday) &&
+		r.Naming.valid()
+}
+
+func (r YearEnd) month() time.Month {
+	if r.Month < time.January || r.Month > time.December {
+		return time.December
+	}
+	return r.Month
+}
+
+// end returns the last day of the year whose rule month falls in year.
+func (r YearEnd) end(year int, loc *time.Location) time.Time {
+	last := time.Date(year, r.month()+1, 0, 0, 0, 0, 0, loc)
+	back := (int(last.Weekday()) - int(r.Weekday) + 7) % 7
+
+	if r.Method == NearestWeekday && back > 3 {
+		return last.AddDate(0, 0, 7-back)
+	}
+	return last.AddDate(0, 0, -back)
+}
+
+// yearOf returns the rule year of the 52/53-week year containing t.
+func (r YearEnd) yearOf(t time.Time) int {
+	t = truncateToDay(t)
+	y := t.Year()
+	loc := t.Location()
+
+	switch {
+	case t.After(r.end(y, loc)):
+		return y + 1
+	case !t.After(r.end(y-1, loc)):
+		return y - 1
+	default:
+		return y
+	}
+}
+
+func (r YearEnd) bounds(t time.Time) (start, end time.Time) {
+	y := r.yearOf(t)
+	loc := t.Location()
+
+	return r.end(y-1, loc).AddDate(0, 0, 1), r.end(y, loc)
+}
+
+// name returns the label of the rule year y.
+func (r YearEnd) name(y int, loc *time.Location) int {
+	if r.Naming == FiscalYearNamedByStartYear {
+		return r.end(y-1, loc).AddDate(0, 0, 1).Year()
+	}
+	return y
+}
+
+func (r YearEnd) weeks(y int, loc *time.Location) int {
+	return daysBetween(r.end(y-1, loc), r.end(y, loc)) / 7
+}
+
+// ruleYear converts a fiscal year name into the year of its rule month.
+func (r YearEnd) ruleYear(fiscalYear int) int {
+	for _, y := range []int{fiscalYear, fiscalYear + 1, fiscalYear - 1} {
+		if r.name(y, time.UTC) == fiscalYear {
+			return y
+		}
+	}
+	return fiscalYear
+}
+
+// Bounds returns the first and last day of the named fiscal year.
+func (r YearEnd) Bounds(fiscalYear int, loc *time.Location) (start, end time.Time) {
+	y := r.ruleYear(fiscalYear)
+	return r.end(y-1, loc).AddDate(0, 0, 1), r.end(y, loc)
+}
+
+// Weeks returns 52 or 53, the length of the named fiscal year.
+func (r YearEnd) Weeks(fiscalYear int) int {
+	return r.weeks(r.ruleYear(fiscalYear), time.UTC)
+}
+
+// FiscalYear returns the name of the fiscal year containing t.
+func (r YearEnd) FiscalYear(t time.Time) int {
+	return r.name(r.yearOf(t), t.Location())
+}